@@ -5,6 +5,8 @@ import (
 	"TajikCareerHub/db"
 	"TajikCareerHub/logger"
 	"TajikCareerHub/pkg/controllers"
+	"TajikCareerHub/pkg/notifier"
+	"TajikCareerHub/pkg/service"
 	"TajikCareerHub/server"
 	"context"
 	"github.com/joho/godotenv"
@@ -45,6 +47,15 @@ func main() {
 	if err := db.Migrate(); err != nil {
 		logger.Error.Fatalf("Failed to run database migrations: %v", err)
 	}
+	digestNotifier, err := notifier.New(configs.AppSettings.NotifierParams)
+	if err != nil {
+		logger.Error.Fatalf("Failed to create notifier: %v", err)
+	}
+
+	jobsCtx, stopJobs := context.WithCancel(context.Background())
+	defer stopJobs()
+	go service.RunVacancyExpiryJob(jobsCtx)
+	go service.RunSavedSearchJob(jobsCtx, digestNotifier)
 
 	mainServer := new(server.Server)
 	go func() {
@@ -56,6 +67,7 @@ func main() {
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGTERM, syscall.SIGINT)
 	<-quit
+	stopJobs()
 
 	if sqlDB, err := db.GetDBConn().DB(); err == nil {
 		if err := sqlDB.Close(); err != nil {
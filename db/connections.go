@@ -50,3 +50,9 @@ func CloseDBConn() error {
 func GetDBConn() *gorm.DB {
 	return dbConn
 }
+
+// SetDBConn replaces the connection used by the repositories, so that tests can run them
+// against a database of their own.
+func SetDBConn(conn *gorm.DB) {
+	dbConn = conn
+}
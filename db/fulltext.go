@@ -0,0 +1,69 @@
+package db
+
+import (
+	"TajikCareerHub/logger"
+	"fmt"
+)
+
+// Vacancy search mixes Tajik, Russian and English text, so the vector holds the
+// russian and english stems plus an unstemmed "simple" copy for Tajik words.
+// Company and category names live in other tables, which rules out a GENERATED
+// column; triggers keep vacancies.search_vector in sync instead.
+var vacancySearchStatements = []string{
+	`ALTER TABLE vacancies ADD COLUMN IF NOT EXISTS search_vector tsvector`,
+	`CREATE OR REPLACE FUNCTION vacancies_search_vector_update() RETURNS trigger AS $$
+DECLARE
+	company_name  text;
+	category_name text;
+BEGIN
+	SELECT name INTO company_name FROM companies WHERE id = NEW.company_id;
+	SELECT name INTO category_name FROM vacancy_categories WHERE id = NEW.vacancy_category_id;
+	NEW.search_vector :=
+		setweight(to_tsvector('russian', coalesce(NEW.title, '')), 'A') ||
+		setweight(to_tsvector('english', coalesce(NEW.title, '')), 'A') ||
+		setweight(to_tsvector('simple', coalesce(NEW.title, '')), 'A') ||
+		setweight(to_tsvector('russian', coalesce(NEW.description, '')), 'B') ||
+		setweight(to_tsvector('english', coalesce(NEW.description, '')), 'B') ||
+		setweight(to_tsvector('simple', coalesce(NEW.description, '')), 'B') ||
+		setweight(to_tsvector('simple', coalesce(company_name, '')), 'C') ||
+		setweight(to_tsvector('simple', coalesce(category_name, '')), 'C');
+	RETURN NEW;
+END
+$$ LANGUAGE plpgsql`,
+	`DROP TRIGGER IF EXISTS vacancies_search_vector_trigger ON vacancies`,
+	`CREATE TRIGGER vacancies_search_vector_trigger
+	BEFORE INSERT OR UPDATE OF title, description, company_id, vacancy_category_id ON vacancies
+	FOR EACH ROW EXECUTE FUNCTION vacancies_search_vector_update()`,
+	`CREATE OR REPLACE FUNCTION vacancies_search_vector_refresh_company() RETURNS trigger AS $$
+BEGIN
+	UPDATE vacancies SET title = title WHERE company_id = NEW.id;
+	RETURN NULL;
+END
+$$ LANGUAGE plpgsql`,
+	`DROP TRIGGER IF EXISTS companies_search_vector_trigger ON companies`,
+	`CREATE TRIGGER companies_search_vector_trigger
+	AFTER UPDATE OF name ON companies
+	FOR EACH ROW EXECUTE FUNCTION vacancies_search_vector_refresh_company()`,
+	`CREATE OR REPLACE FUNCTION vacancies_search_vector_refresh_category() RETURNS trigger AS $$
+BEGIN
+	UPDATE vacancies SET title = title WHERE vacancy_category_id = NEW.id;
+	RETURN NULL;
+END
+$$ LANGUAGE plpgsql`,
+	`DROP TRIGGER IF EXISTS vacancy_categories_search_vector_trigger ON vacancy_categories`,
+	`CREATE TRIGGER vacancy_categories_search_vector_trigger
+	AFTER UPDATE OF name ON vacancy_categories
+	FOR EACH ROW EXECUTE FUNCTION vacancies_search_vector_refresh_category()`,
+	`CREATE INDEX IF NOT EXISTS idx_vacancies_search_vector ON vacancies USING GIN (search_vector)`,
+	`UPDATE vacancies SET title = title WHERE search_vector IS NULL`,
+}
+
+func migrateVacancySearch() error {
+	for _, statement := range vacancySearchStatements {
+		if err := dbConn.Exec(statement).Error; err != nil {
+			return fmt.Errorf("failed to migrate vacancy full-text search: %v", err)
+		}
+	}
+	logger.Info.Println("Vacancy full-text search migrated successfully")
+	return nil
+}
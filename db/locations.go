@@ -0,0 +1,94 @@
+package db
+
+import (
+	"TajikCareerHub/models"
+	"fmt"
+)
+
+type locationSeed struct {
+	Type     string
+	NameTJ   string
+	NameRU   string
+	NameEN   string
+	Children []locationSeed
+}
+
+// tajikistanLocations holds the regions of Tajikistan with their main cities. Dushanbe is a
+// city of republican subordination and is listed on its own with its districts.
+var tajikistanLocations = []locationSeed{
+	{Type: models.LocationTypeCity, NameTJ: "Душанбе", NameRU: "Душанбе", NameEN: "Dushanbe", Children: []locationSeed{
+		{Type: models.LocationTypeDistrict, NameTJ: "Сино", NameRU: "Сино", NameEN: "Sino"},
+		{Type: models.LocationTypeDistrict, NameTJ: "Фирдавсӣ", NameRU: "Фирдавси", NameEN: "Firdavsi"},
+		{Type: models.LocationTypeDistrict, NameTJ: "Исмоили Сомонӣ", NameRU: "Исмоили Сомони", NameEN: "Ismoili Somoni"},
+		{Type: models.LocationTypeDistrict, NameTJ: "Шоҳмансур", NameRU: "Шохмансур", NameEN: "Shohmansur"},
+	}},
+	{Type: models.LocationTypeRegion, NameTJ: "Вилояти Суғд", NameRU: "Согдийская область", NameEN: "Sughd", Children: []locationSeed{
+		{Type: models.LocationTypeCity, NameTJ: "Хуҷанд", NameRU: "Худжанд", NameEN: "Khujand"},
+		{Type: models.LocationTypeCity, NameTJ: "Истаравшан", NameRU: "Истаравшан", NameEN: "Istaravshan"},
+		{Type: models.LocationTypeCity, NameTJ: "Исфара", NameRU: "Исфара", NameEN: "Isfara"},
+		{Type: models.LocationTypeCity, NameTJ: "Конибодом", NameRU: "Канибадам", NameEN: "Konibodom"},
+		{Type: models.LocationTypeCity, NameTJ: "Панҷакент", NameRU: "Пенджикент", NameEN: "Panjakent"},
+		{Type: models.LocationTypeCity, NameTJ: "Бӯстон", NameRU: "Бустон", NameEN: "Buston"},
+		{Type: models.LocationTypeCity, NameTJ: "Гулистон", NameRU: "Гулистон", NameEN: "Guliston"},
+		{Type: models.LocationTypeCity, NameTJ: "Истиқлол", NameRU: "Истиклол", NameEN: "Istiqlol"},
+	}},
+	{Type: models.LocationTypeRegion, NameTJ: "Вилояти Хатлон", NameRU: "Хатлонская область", NameEN: "Khatlon", Children: []locationSeed{
+		{Type: models.LocationTypeCity, NameTJ: "Бохтар", NameRU: "Бохтар", NameEN: "Bokhtar"},
+		{Type: models.LocationTypeCity, NameTJ: "Кӯлоб", NameRU: "Куляб", NameEN: "Kulob"},
+		{Type: models.LocationTypeCity, NameTJ: "Леваканд", NameRU: "Леваканд", NameEN: "Levakant"},
+		{Type: models.LocationTypeCity, NameTJ: "Норак", NameRU: "Нурек", NameEN: "Norak"},
+	}},
+	{Type: models.LocationTypeRegion, NameTJ: "ВМКБ", NameRU: "ГБАО", NameEN: "GBAO", Children: []locationSeed{
+		{Type: models.LocationTypeCity, NameTJ: "Хоруғ", NameRU: "Хорог", NameEN: "Khorugh"},
+	}},
+	{Type: models.LocationTypeRegion, NameTJ: "Ноҳияҳои тобеи ҷумҳурӣ", NameRU: "Районы республиканского подчинения", NameEN: "Districts of Republican Subordination", Children: []locationSeed{
+		{Type: models.LocationTypeCity, NameTJ: "Ваҳдат", NameRU: "Вахдат", NameEN: "Vahdat"},
+		{Type: models.LocationTypeCity, NameTJ: "Ҳисор", NameRU: "Гиссар", NameEN: "Hisor"},
+		{Type: models.LocationTypeCity, NameTJ: "Турсунзода", NameRU: "Турсунзаде", NameEN: "Tursunzoda"},
+		{Type: models.LocationTypeCity, NameTJ: "Роғун", NameRU: "Рогун", NameEN: "Rogun"},
+	}},
+}
+
+// seedLocations inserts the locations missing from the dictionary, matched by English name.
+func seedLocations() error {
+	for _, seed := range tajikistanLocations {
+		if err := seedLocation(nil, seed); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func seedLocation(parentID *uint, seed locationSeed) error {
+	location := models.Location{
+		ParentID: parentID,
+		Type:     seed.Type,
+		NameTJ:   seed.NameTJ,
+		NameRU:   seed.NameRU,
+		NameEN:   seed.NameEN,
+	}
+	if err := dbConn.Where("name_en = ?", seed.NameEN).FirstOrCreate(&location).Error; err != nil {
+		return fmt.Errorf("failed to insert location %s: %v", seed.NameEN, err)
+	}
+	for _, child := range seed.Children {
+		if err := seedLocation(&location.ID, child); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// linkLocations links the vacancies and resumes whose free text location names a location
+// from the dictionary in any language.
+func linkLocations() error {
+	for _, table := range []string{"vacancies", "resumes"} {
+		err := dbConn.Exec(`UPDATE ` + table + ` t SET location_id = l.id, location = l.name_en
+			FROM locations l
+			WHERE t.location_id IS NULL AND t.location <> ''
+				AND lower(btrim(t.location)) IN (lower(l.name_tj), lower(l.name_ru), lower(l.name_en))`).Error
+		if err != nil {
+			return fmt.Errorf("failed to link %s to locations: %v", table, err)
+		}
+	}
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 	"TajikCareerHub/models"
 	"errors"
 	"fmt"
+	"gorm.io/gorm"
 )
 
 func Migrate() error {
@@ -12,17 +13,57 @@ func Migrate() error {
 		return errors.New("database connection is not initialized")
 	}
 	migrateModels := []interface{}{
+		&models.Location{},
+		&models.Skill{},
+		&models.SkillAlias{},
 		&models.Vacancy{},
+		&models.VacancyLanguage{},
 		&models.User{},
 		&models.Application{},
 		&models.Company{},
 		&models.VacancyCategory{},
 		&models.ResumeView{},
 		&models.Resume{},
+		&models.ResumeExperience{},
+		&models.ResumeEducation{},
+		&models.ResumeSkill{},
 		&models.VacancyView{},
 		&models.ApplicationStatus{},
+		&models.ApplicationStatusHistory{},
+		&models.ApplicationNote{},
+		&models.Interview{},
+		&models.Permission{},
 		&models.Role{},
+		&models.RefreshToken{},
+		&models.CompanyMember{},
+		&models.CompanyInvitation{},
+		&models.Notification{},
+		&models.SavedSearch{},
+		&models.VacancyFavorite{},
+		&models.ResumeShortlistItem{},
 	}
+	if err := deduplicateApplications(); err != nil {
+		return err
+	}
+	if err := deduplicateCompanyInvitations(); err != nil {
+		return err
+	}
+	// Vacancies created before the lifecycle was introduced were live, they are published
+	// once the status column is added.
+	publishExisting := dbConn.Migrator().HasTable(&models.Vacancy{}) &&
+		!dbConn.Migrator().HasColumn(&models.Vacancy{}, "status")
+	// Vacancies published before re-publishing was tracked were last published when they
+	// were first published.
+	backfillLastPublished := dbConn.Migrator().HasTable(&models.Vacancy{}) &&
+		!dbConn.Migrator().HasColumn(&models.Vacancy{}, "last_published_at")
+	// Companies created before verification was introduced were trusted, they are verified
+	// once the verification columns are added.
+	verifyExisting := dbConn.Migrator().HasTable(&models.Company{}) &&
+		!dbConn.Migrator().HasColumn(&models.Company{}, "verification_status")
+	// Vacancies created before salary ranges were introduced had a single amount, it is
+	// copied into the range once the range columns are added.
+	migrateSalary := dbConn.Migrator().HasTable(&models.Vacancy{}) &&
+		!dbConn.Migrator().HasColumn(&models.Vacancy{}, "salary_min")
 	for _, model := range migrateModels {
 		err := dbConn.AutoMigrate(model)
 		if err != nil {
@@ -31,24 +72,57 @@ func Migrate() error {
 		logger.Info.Printf("Migrated model: %T\n", model)
 	}
 
-	initialStatuses := []models.ApplicationStatus{
-		{Name: "applied"},
-		{Name: "under_review"},
-		{Name: "rejected"},
-		{Name: "interview"},
+	if err := migrateCompanyOwners(); err != nil {
+		return err
 	}
 
-	var count int64
-	err := dbConn.Model(&models.ApplicationStatus{}).Count(&count).Error
-	if err != nil {
-		return fmt.Errorf("failed to count application statuses: %v", err)
+	if err := seedApplicationStatuses(); err != nil {
+		return err
 	}
 
-	if count == 0 {
-		if err := dbConn.Create(&initialStatuses).Error; err != nil {
-			return fmt.Errorf("failed to insert initial application statuses: %v", err)
+	if err := backfillResumeSkills(); err != nil {
+		return err
+	}
+
+	if err := seedLocations(); err != nil {
+		return err
+	}
+
+	if err := linkLocations(); err != nil {
+		return err
+	}
+
+	if migrateSalary {
+		if err := migrateVacancySalary(); err != nil {
+			return err
+		}
+	}
+
+	if publishExisting {
+		err := dbConn.Model(&models.Vacancy{}).
+			Where("status = ?", models.VacancyStatusDraft).
+			UpdateColumns(map[string]interface{}{"status": models.VacancyStatusPublished, "published_at": gorm.Expr("created_at")}).Error
+		if err != nil {
+			return fmt.Errorf("failed to publish existing vacancies: %v", err)
+		}
+	}
+
+	if backfillLastPublished {
+		err := dbConn.Model(&models.Vacancy{}).
+			Where("last_published_at IS NULL AND published_at IS NOT NULL").
+			UpdateColumn("last_published_at", gorm.Expr("published_at")).Error
+		if err != nil {
+			return fmt.Errorf("failed to backfill vacancy publish dates: %v", err)
+		}
+	}
+
+	if verifyExisting {
+		err := dbConn.Model(&models.Company{}).
+			Where("verification_status = ?", models.CompanyStatusPending).
+			UpdateColumn("verification_status", models.CompanyStatusVerified).Error
+		if err != nil {
+			return fmt.Errorf("failed to verify existing companies: %v", err)
 		}
-		logger.Info.Println("Initial application statuses inserted successfully")
 	}
 
 	initialRoles := []models.Role{
@@ -57,7 +131,8 @@ func Migrate() error {
 		{Name: "employer"},
 	}
 
-	err = dbConn.Model(&models.Role{}).Count(&count).Error
+	var count int64
+	err := dbConn.Model(&models.Role{}).Count(&count).Error
 	if err != nil {
 		return fmt.Errorf("failed to count roles: %v", err)
 	}
@@ -69,6 +144,172 @@ func Migrate() error {
 		logger.Info.Println("Initial roles inserted successfully")
 	}
 
+	if err := seedRolePermissions(); err != nil {
+		return err
+	}
+
+	if err := migrateVacancySearch(); err != nil {
+		return err
+	}
+
 	logger.Info.Println("Database migration completed successfully")
 	return nil
 }
+
+var rolePermissions = map[string][]string{
+	models.RoleAdmin: {
+		models.PermissionUserList,
+		models.PermissionUserBlock,
+		models.PermissionUserSessionRevoke,
+		models.PermissionVacancyWrite,
+		models.PermissionVacancyBlock,
+		models.PermissionResumeWrite,
+		models.PermissionResumeBlock,
+		models.PermissionCompanyWrite,
+		models.PermissionCompanyVerify,
+		models.PermissionCompanyBlock,
+		models.PermissionCategoryWrite,
+		models.PermissionSkillWrite,
+		models.PermissionApplicationStatus,
+		models.PermissionManageAnyResource,
+	},
+	models.RoleSpecialist: {
+		models.PermissionResumeWrite,
+		models.PermissionApplicationWrite,
+	},
+	models.RoleEmployer: {
+		models.PermissionVacancyWrite,
+		models.PermissionCompanyWrite,
+		models.PermissionApplicationStatus,
+	},
+}
+
+// deduplicateApplications soft deletes repeated applications of a user to the same vacancy,
+// keeping the first one, so that the unique index on applications can be created.
+func deduplicateApplications() error {
+	if !dbConn.Migrator().HasTable(&models.Application{}) {
+		return nil
+	}
+	err := dbConn.Exec(`UPDATE applications a SET deleted_at = true
+		WHERE a.deleted_at = false AND EXISTS (
+			SELECT 1 FROM applications b
+			WHERE b.user_id = a.user_id AND b.vacancy_id = a.vacancy_id
+				AND b.deleted_at = false AND b.id < a.id
+		)`).Error
+	if err != nil {
+		return fmt.Errorf("failed to deduplicate applications: %v", err)
+	}
+	return nil
+}
+
+// migrateCompanyOwners moves the owners of companies created before company members were
+// introduced from companies.user_id to company_members, then drops the old column.
+func migrateCompanyOwners() error {
+	if !dbConn.Migrator().HasColumn(&models.Company{}, "user_id") {
+		return nil
+	}
+	err := dbConn.Exec(`INSERT INTO company_members (company_id, user_id, role, created_at)
+		SELECT c.id, c.user_id, ?, now() FROM companies c
+		WHERE c.user_id IS NOT NULL AND c.user_id <> 0
+			AND NOT EXISTS (SELECT 1 FROM company_members m WHERE m.company_id = c.id)`,
+		models.CompanyMemberRoleOwner).Error
+	if err != nil {
+		return fmt.Errorf("failed to migrate company owners: %v", err)
+	}
+	if err = dbConn.Migrator().DropColumn(&models.Company{}, "user_id"); err != nil {
+		return fmt.Errorf("failed to drop company user_id column: %v", err)
+	}
+	return nil
+}
+
+// deduplicateCompanyInvitations soft deletes repeated pending invitations of a user to the
+// same company, keeping the first one, so that the unique index on pending invitations can
+// be created.
+func deduplicateCompanyInvitations() error {
+	if !dbConn.Migrator().HasTable(&models.CompanyInvitation{}) {
+		return nil
+	}
+	err := dbConn.Exec(`UPDATE company_invitations a SET deleted_at = true
+		WHERE a.deleted_at = false AND a.status = ? AND EXISTS (
+			SELECT 1 FROM company_invitations b
+			WHERE b.company_id = a.company_id AND b.user_id = a.user_id AND b.status = a.status
+				AND b.deleted_at = false AND b.id < a.id
+		)`, models.InvitationStatusPending).Error
+	if err != nil {
+		return fmt.Errorf("failed to deduplicate company invitations: %v", err)
+	}
+	return nil
+}
+
+// backfillResumeSkills splits the free text skills of resumes that have no skill tags yet
+// into normalized tags, the same way models.NormalizeSkillName does.
+func backfillResumeSkills() error {
+	err := dbConn.Exec(`INSERT INTO resume_skills (resume_id, name)
+		SELECT DISTINCT t.resume_id, t.name FROM (
+			SELECT r.id AS resume_id, btrim(regexp_replace(lower(s.name), '\s+', ' ', 'g')) AS name
+			FROM resumes r
+			CROSS JOIN LATERAL regexp_split_to_table(r.skills, '[,;\n]') AS s(name)
+			WHERE r.skills <> ''
+				AND NOT EXISTS (SELECT 1 FROM resume_skills rs WHERE rs.resume_id = r.id)
+		) t
+		WHERE t.name <> '' AND char_length(t.name) <= ?`,
+		models.MaxSkillNameLength).Error
+	if err != nil {
+		return fmt.Errorf("failed to backfill resume skills: %v", err)
+	}
+	return nil
+}
+
+// migrateVacancySalary copies the single salary amount of older vacancies into the
+// salary range columns. The old column is kept for a release, so that the previous version
+// still finds the amounts after a rollback, and is dropped by a later migration.
+func migrateVacancySalary() error {
+	if !dbConn.Migrator().HasColumn(&models.Vacancy{}, "salary") {
+		return nil
+	}
+	err := dbConn.Exec(`UPDATE vacancies SET salary_min = salary, salary_max = salary
+		WHERE salary > 0 AND salary_min = 0 AND salary_max = 0`).Error
+	if err != nil {
+		return fmt.Errorf("failed to migrate vacancy salaries: %v", err)
+	}
+	return nil
+}
+
+func seedApplicationStatuses() error {
+	for _, name := range models.ApplicationStatusNames {
+		var status models.ApplicationStatus
+		err := dbConn.Where("name = ?", name).
+			FirstOrCreate(&status, models.ApplicationStatus{Name: name}).Error
+		if err != nil {
+			return fmt.Errorf("failed to insert application status %s: %v", name, err)
+		}
+	}
+	logger.Info.Println("Application statuses seeded successfully")
+	return nil
+}
+
+func seedRolePermissions() error {
+	for roleName, permissionNames := range rolePermissions {
+		var role models.Role
+		if err := dbConn.Where("name = ?", roleName).FirstOrCreate(&role, models.Role{Name: roleName}).Error; err != nil {
+			return fmt.Errorf("failed to find role %s: %v", roleName, err)
+		}
+
+		permissions := make([]models.Permission, 0, len(permissionNames))
+		for _, permissionName := range permissionNames {
+			var permission models.Permission
+			err := dbConn.Where("name = ?", permissionName).
+				FirstOrCreate(&permission, models.Permission{Name: permissionName}).Error
+			if err != nil {
+				return fmt.Errorf("failed to insert permission %s: %v", permissionName, err)
+			}
+			permissions = append(permissions, permission)
+		}
+
+		if err := dbConn.Model(&role).Association("Permissions").Replace(permissions); err != nil {
+			return fmt.Errorf("failed to assign permissions to role %s: %v", roleName, err)
+		}
+	}
+	logger.Info.Println("Role permissions seeded successfully")
+	return nil
+}
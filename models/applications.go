@@ -1,21 +1,137 @@
 package models
 
+import (
+	"TajikCareerHub/utils/errs"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	ApplicationStatusApplied     = "applied"
+	ApplicationStatusUnderReview = "under_review"
+	ApplicationStatusInterview   = "interview"
+	ApplicationStatusOffer       = "offer"
+	ApplicationStatusHired       = "hired"
+	ApplicationStatusRejected    = "rejected"
+	ApplicationStatusWithdrawn   = "withdrawn"
+)
+
+// ApplicationStatusNames lists every application status in the order they are seeded.
+var ApplicationStatusNames = []string{
+	ApplicationStatusApplied,
+	ApplicationStatusUnderReview,
+	ApplicationStatusRejected,
+	ApplicationStatusInterview,
+	ApplicationStatusOffer,
+	ApplicationStatusHired,
+	ApplicationStatusWithdrawn,
+}
+
+// applicationStatusTransitions holds the statuses reachable from each status.
+// Hired, rejected and withdrawn are terminal.
+var applicationStatusTransitions = map[string][]string{
+	ApplicationStatusApplied:     {ApplicationStatusUnderReview, ApplicationStatusRejected, ApplicationStatusWithdrawn},
+	ApplicationStatusUnderReview: {ApplicationStatusInterview, ApplicationStatusOffer, ApplicationStatusRejected, ApplicationStatusWithdrawn},
+	ApplicationStatusInterview:   {ApplicationStatusOffer, ApplicationStatusRejected, ApplicationStatusWithdrawn},
+	ApplicationStatusOffer:       {ApplicationStatusHired, ApplicationStatusRejected, ApplicationStatusWithdrawn},
+}
+
+func CanTransitionApplicationStatus(from, to string) bool {
+	for _, status := range applicationStatusTransitions[from] {
+		if status == to {
+			return true
+		}
+	}
+	return false
+}
+
 type Application struct {
 	ID        uint              `json:"id" gorm:"primaryKey"`
-	UserID    uint              `json:"user_id" gorm:"not null"`
+	UserID    uint              `json:"user_id" gorm:"not null;uniqueIndex:idx_application_user_vacancy,where:deleted_at = false"`
 	User      User              `json:"user" gorm:"foreignKey:UserID"`
-	VacancyID uint              `json:"vacancy_id" gorm:"not null"`
+	VacancyID uint              `json:"vacancy_id" gorm:"not null;uniqueIndex:idx_application_user_vacancy,where:deleted_at = false"`
 	Vacancy   Vacancy           `json:"vacancy" gorm:"foreignKey:VacancyID"`
 	ResumeID  uint              `json:"resume_id" gorm:"not null"`
 	Resume    Resume            `json:"resume" gorm:"foreignKey:ResumeID"`
 	StatusID  uint              `json:"status_id" gorm:"not null"`
 	Status    ApplicationStatus `json:"status" gorm:"foreignKey:StatusID"`
+	// CoverLetter is written by the applicant. Rating and Notes are private to the employer
+	// and are only filled in the employer views.
+	CoverLetter string            `json:"cover_letter" gorm:"type:text"`
+	Rating      *uint             `json:"rating,omitempty"`
+	Notes       []ApplicationNote `json:"notes,omitempty" gorm:"foreignKey:ApplicationID"`
 	BaseModel
 }
 
+func (a Application) ValidateApplication() error {
+	if utf8.RuneCountInString(a.CoverLetter) > 5000 {
+		return errs.ErrCoverLetterTooLong
+	}
+	return nil
+}
+
+type ApplicationNote struct {
+	ID            uint      `json:"id" gorm:"primaryKey"`
+	ApplicationID uint      `json:"application_id" gorm:"not null;index"`
+	AuthorID      uint      `json:"author_id" gorm:"not null"`
+	Author        User      `json:"author" gorm:"foreignKey:AuthorID"`
+	Text          string    `json:"text" gorm:"type:text;not null"`
+	CreatedAt     time.Time `json:"created_at" gorm:"autoCreateTime"`
+}
+
+func (n ApplicationNote) ValidateNote() error {
+	if strings.TrimSpace(n.Text) == "" {
+		return errs.ErrNoteTextIsRequired
+	}
+	if utf8.RuneCountInString(n.Text) > 2000 {
+		return errs.ErrNoteTooLong
+	}
+	return nil
+}
+
+func IsValidApplicationRating(rating uint) bool {
+	return rating >= 1 && rating <= 5
+}
+
 type ApplicationStatus struct {
-	ID   uint   `gorm:"primaryKey"`
-	Name string `gorm:"type:varchar(50);not null"`
+	ID   uint   `json:"id" gorm:"primaryKey"`
+	Name string `json:"name" gorm:"type:varchar(50);not null"`
+}
+
+type ApplicationStatusHistory struct {
+	ID            uint               `json:"id" gorm:"primaryKey"`
+	ApplicationID uint               `json:"application_id" gorm:"not null;index"`
+	FromStatusID  *uint              `json:"from_status_id"`
+	FromStatus    *ApplicationStatus `json:"from_status,omitempty" gorm:"foreignKey:FromStatusID"`
+	ToStatusID    uint               `json:"to_status_id" gorm:"not null"`
+	ToStatus      ApplicationStatus  `json:"to_status" gorm:"foreignKey:ToStatusID"`
+	ChangedByID   uint               `json:"changed_by_id" gorm:"not null"`
+	ChangedBy     User               `json:"changed_by" gorm:"foreignKey:ChangedByID"`
+	CreatedAt     time.Time          `json:"created_at" gorm:"autoCreateTime"`
+}
+
+func (ApplicationStatusHistory) TableName() string {
+	return "application_status_history"
+}
+
+// ApplicationFilter narrows the applications received by an employer. Zero values are ignored.
+type ApplicationFilter struct {
+	VacancyID          uint
+	EmployerID         uint
+	Status             string
+	CreatedFrom        *time.Time
+	CreatedTo          *time.Time
+	MinExperienceYears int
+}
+
+func IsValidApplicationStatus(name string) bool {
+	for _, status := range ApplicationStatusNames {
+		if status == name {
+			return true
+		}
+	}
+	return false
 }
 
 type SpecialistActivityReport struct {
@@ -25,10 +141,17 @@ type SpecialistActivityReport struct {
 }
 
 type SwaggerApplication struct {
-	UserID    uint `json:"user_id" example:"1"`
-	VacancyID uint `json:"vacancy_id" example:"1"`
-	ResumeID  uint `json:"resume_id" example:"1"`
-	StatusID  uint `json:"status_id" example:"1"`
+	VacancyID   uint   `json:"vacancy_id" example:"1"`
+	ResumeID    uint   `json:"resume_id" example:"1"`
+	CoverLetter string `json:"cover_letter"`
+}
+
+type SwagApplicationNote struct {
+	Text string `json:"text"`
+}
+
+type SwagApplicationRating struct {
+	Rating uint `json:"rating" example:"4"`
 }
 
 type ResumeView struct {
@@ -0,0 +1,88 @@
+package models
+
+import (
+	"TajikCareerHub/utils/errs"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	MaxBookmarkTags       = 10
+	MaxBookmarkTagLength  = 30
+	MaxBookmarkNoteLength = 1000
+)
+
+// VacancyFavorite is a vacancy a specialist keeps for later. Favorites are removed together
+// with the vacancy. A vacancy that is no longer listed, for example because it was blocked,
+// closed or expired, stays in the favorites but isn't Available, its details are then left
+// out and only the Title saved with the favorite is shown.
+type VacancyFavorite struct {
+	ID        uint      `json:"id" gorm:"primaryKey"`
+	UserID    uint      `json:"-" gorm:"not null;uniqueIndex:idx_vacancy_favorite"`
+	VacancyID uint      `json:"vacancy_id" gorm:"not null;uniqueIndex:idx_vacancy_favorite;index"`
+	Vacancy   *Vacancy  `json:"vacancy,omitempty" gorm:"foreignKey:VacancyID"`
+	Title     string    `json:"title" gorm:"type:varchar(255)"`
+	Tags      []string  `json:"tags" gorm:"type:jsonb;serializer:json;not null"`
+	Note      string    `json:"note" gorm:"type:text"`
+	Available bool      `json:"available" gorm:"->;-:migration"`
+	CreatedAt time.Time `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt time.Time `json:"updated_at" gorm:"autoUpdateTime"`
+}
+
+// ResumeShortlistItem is a resume an employer shortlisted. Like favorites, items are removed
+// together with the resume and flagged as not Available while the resume or its author is
+// blocked.
+type ResumeShortlistItem struct {
+	ID        uint      `json:"id" gorm:"primaryKey"`
+	UserID    uint      `json:"-" gorm:"not null;uniqueIndex:idx_resume_shortlist_item"`
+	ResumeID  uint      `json:"resume_id" gorm:"not null;uniqueIndex:idx_resume_shortlist_item;index"`
+	Resume    *Resume   `json:"resume,omitempty" gorm:"foreignKey:ResumeID"`
+	Title     string    `json:"title" gorm:"type:varchar(255)"`
+	Tags      []string  `json:"tags" gorm:"type:jsonb;serializer:json;not null"`
+	Note      string    `json:"note" gorm:"type:text"`
+	Available bool      `json:"available" gorm:"->;-:migration"`
+	CreatedAt time.Time `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt time.Time `json:"updated_at" gorm:"autoUpdateTime"`
+}
+
+// SwagBookmark holds the tags and note of a favorite or a shortlisted resume.
+type SwagBookmark struct {
+	Tags []string `json:"tags" example:"backend,remote"`
+	Note string   `json:"note" example:"Ask about relocation"`
+}
+
+// NormalizeBookmarkTag lowercases the tag and collapses whitespace like skill names.
+func NormalizeBookmarkTag(tag string) string {
+	return strings.Join(strings.Fields(strings.ToLower(tag)), " ")
+}
+
+// Normalize normalizes the tags, drops empty and repeated ones, and trims the note.
+func (b SwagBookmark) Normalize() SwagBookmark {
+	tags := make([]string, 0, len(b.Tags))
+	seen := make(map[string]bool, len(b.Tags))
+	for _, tag := range b.Tags {
+		tag = NormalizeBookmarkTag(tag)
+		if tag == "" || seen[tag] {
+			continue
+		}
+		seen[tag] = true
+		tags = append(tags, tag)
+	}
+	return SwagBookmark{Tags: tags, Note: strings.TrimSpace(b.Note)}
+}
+
+func (b SwagBookmark) ValidateBookmark() error {
+	if len(b.Tags) > MaxBookmarkTags {
+		return errs.ErrTooManyBookmarkTags
+	}
+	for _, tag := range b.Tags {
+		if utf8.RuneCountInString(tag) > MaxBookmarkTagLength {
+			return errs.ErrInvalidBookmarkTag
+		}
+	}
+	if utf8.RuneCountInString(b.Note) > MaxBookmarkNoteLength {
+		return errs.ErrBookmarkNoteTooLong
+	}
+	return nil
+}
@@ -1,9 +1,22 @@
 package models
 
+import "time"
+
+const (
+	CompanyStatusPending  = "pending"
+	CompanyStatusVerified = "verified"
+	CompanyStatusRejected = "rejected"
+)
+
 type Company struct {
-	ID          uint   `json:"id" gorm:"primaryKey"`
-	Name        string `json:"name" gorm:"type:varchar(100);unique;not null"`
-	Description string `json:"description" gorm:"type:text"`
+	ID                 uint       `json:"id" gorm:"primaryKey"`
+	Name               string     `json:"name" gorm:"type:varchar(100);unique;not null"`
+	Description        string     `json:"description" gorm:"type:text"`
+	VerificationStatus string     `json:"verification_status" gorm:"type:varchar(20);not null;default:pending"`
+	ReviewerNote       string     `json:"reviewer_note" gorm:"type:text"`
+	ReviewedByID       *uint      `json:"reviewed_by_id"`
+	ReviewedAt         *time.Time `json:"reviewed_at"`
+	IsBlocked          bool       `json:"-" gorm:"default:false"`
 	BaseModel
 }
 
@@ -11,3 +24,8 @@ type SwagCompany struct {
 	Name        string `json:"name"`
 	Description string `json:"description"`
 }
+
+type SwagCompanyVerification struct {
+	Status string `json:"status" example:"verified"`
+	Note   string `json:"note"`
+}
@@ -0,0 +1,47 @@
+package models
+
+import "time"
+
+const (
+	CompanyMemberRoleOwner     = "owner"
+	CompanyMemberRoleRecruiter = "recruiter"
+)
+
+const (
+	InvitationStatusPending  = "pending"
+	InvitationStatusAccepted = "accepted"
+	InvitationStatusDeclined = "declined"
+)
+
+type CompanyMember struct {
+	ID        uint      `json:"id" gorm:"primaryKey"`
+	CompanyID uint      `json:"company_id" gorm:"not null;uniqueIndex:idx_company_member"`
+	Company   Company   `json:"-" gorm:"foreignKey:CompanyID"`
+	UserID    uint      `json:"user_id" gorm:"not null;uniqueIndex:idx_company_member"`
+	User      User      `json:"user" gorm:"foreignKey:UserID"`
+	Role      string    `json:"role" gorm:"type:varchar(20);not null"`
+	CreatedAt time.Time `json:"created_at" gorm:"autoCreateTime"`
+}
+
+// CompanyInvitation invites a user to join a company. A user has at most one pending
+// invitation to a company.
+type CompanyInvitation struct {
+	ID          uint    `json:"id" gorm:"primaryKey"`
+	CompanyID   uint    `json:"company_id" gorm:"not null;index;uniqueIndex:idx_pending_company_invitation,where:status = 'pending' AND deleted_at = false"`
+	Company     Company `json:"company" gorm:"foreignKey:CompanyID"`
+	UserID      uint    `json:"user_id" gorm:"not null;index;uniqueIndex:idx_pending_company_invitation,where:status = 'pending' AND deleted_at = false"`
+	InvitedByID uint    `json:"invited_by_id" gorm:"not null"`
+	Role        string  `json:"role" gorm:"type:varchar(20);not null"`
+	Status      string  `json:"status" gorm:"type:varchar(20);not null;default:pending"`
+	BaseModel
+}
+
+type SwagCompanyInvitation struct {
+	UserName string `json:"username"`
+	Email    string `json:"email"`
+	Role     string `json:"role" example:"recruiter"`
+}
+
+func IsValidCompanyMemberRole(role string) bool {
+	return role == CompanyMemberRoleOwner || role == CompanyMemberRoleRecruiter
+}
@@ -1,14 +1,19 @@
 package models
 
 type AppConfig struct {
-	AuthParams     AuthParams     `json:"auth"`
-	LogParams      LogParams      `json:"log_params"`
-	AppParams      AppParams      `json:"app_params"`
-	PostgresParams PostgresParams `json:"postgres_params"`
+	AuthParams        AuthParams        `json:"auth"`
+	LogParams         LogParams         `json:"log_params"`
+	AppParams         AppParams         `json:"app_params"`
+	PostgresParams    PostgresParams    `json:"postgres_params"`
+	ExchangeRates     ExchangeRates     `json:"exchange_rates"`
+	VacancyParams     VacancyParams     `json:"vacancy_params"`
+	SavedSearchParams SavedSearchParams `json:"saved_search_params"`
+	NotifierParams    NotifierParams    `json:"notifier_params"`
 }
 
 type AuthParams struct {
-	JwtTtlMinutes int `json:"jwt_ttl_minutes"`
+	JwtTtlMinutes   int `json:"jwt_ttl_minutes"`
+	RefreshTtlHours int `json:"refresh_ttl_hours"`
 }
 
 type LogParams struct {
@@ -31,6 +36,29 @@ type AppParams struct {
 	ServerName string `json:"server_name"`
 }
 
+type VacancyParams struct {
+	DefaultLifetimeDays        int `json:"default_lifetime_days"`
+	MaxLifetimeDays            int `json:"max_lifetime_days"`
+	ExpiryCheckIntervalMinutes int `json:"expiry_check_interval_minutes"`
+}
+
+type SavedSearchParams struct {
+	CheckIntervalMinutes  int `json:"check_interval_minutes"`
+	MaxVacanciesPerDigest int `json:"max_vacancies_per_digest"`
+}
+
+// NotifierParams selects how digests are delivered: "smtp" sends emails, "file" appends
+// them to FilePath and "stdout" prints them. The SMTP password is read from the
+// SMTP_PASSWORD environment variable.
+type NotifierParams struct {
+	Type         string `json:"type"`
+	FilePath     string `json:"file_path"`
+	SMTPHost     string `json:"smtp_host"`
+	SMTPPort     string `json:"smtp_port"`
+	SMTPUsername string `json:"smtp_username"`
+	From         string `json:"from"`
+}
+
 type PostgresParams struct {
 	Host     string `json:"host"`
 	Port     string `json:"port"`
@@ -0,0 +1,131 @@
+package models
+
+import (
+	"TajikCareerHub/utils/errs"
+	"strings"
+)
+
+const (
+	EmploymentTypeFullTime   = "full_time"
+	EmploymentTypePartTime   = "part_time"
+	EmploymentTypeContract   = "contract"
+	EmploymentTypeTemporary  = "temporary"
+	EmploymentTypeInternship = "internship"
+)
+
+const (
+	WorkScheduleFullDay  = "full_day"
+	WorkScheduleShift    = "shift"
+	WorkScheduleFlexible = "flexible"
+	WorkScheduleRotation = "rotation"
+)
+
+const (
+	RemoteModeOnsite = "onsite"
+	RemoteModeRemote = "remote"
+	RemoteModeHybrid = "hybrid"
+)
+
+// ExperienceYearsLimit is the largest number of years an experience range can mention.
+const ExperienceYearsLimit = 50
+
+// Language levels follow the CEFR scale, native speakers have their own level.
+const (
+	LanguageLevelA1     = "a1"
+	LanguageLevelA2     = "a2"
+	LanguageLevelB1     = "b1"
+	LanguageLevelB2     = "b2"
+	LanguageLevelC1     = "c1"
+	LanguageLevelC2     = "c2"
+	LanguageLevelNative = "native"
+)
+
+func IsValidEmploymentType(employmentType string) bool {
+	switch employmentType {
+	case EmploymentTypeFullTime, EmploymentTypePartTime, EmploymentTypeContract,
+		EmploymentTypeTemporary, EmploymentTypeInternship:
+		return true
+	}
+	return false
+}
+
+func IsValidWorkSchedule(schedule string) bool {
+	switch schedule {
+	case WorkScheduleFullDay, WorkScheduleShift, WorkScheduleFlexible, WorkScheduleRotation:
+		return true
+	}
+	return false
+}
+
+func IsValidRemoteMode(mode string) bool {
+	switch mode {
+	case RemoteModeOnsite, RemoteModeRemote, RemoteModeHybrid:
+		return true
+	}
+	return false
+}
+
+func IsValidLanguageLevel(level string) bool {
+	switch level {
+	case LanguageLevelA1, LanguageLevelA2, LanguageLevelB1, LanguageLevelB2,
+		LanguageLevelC1, LanguageLevelC2, LanguageLevelNative:
+		return true
+	}
+	return false
+}
+
+// IsValidLanguageCode accepts two letter ISO 639-1 codes in lower case, such as "tg" or "en".
+func IsValidLanguageCode(code string) bool {
+	if len(code) != 2 {
+		return false
+	}
+	for _, r := range code {
+		if r < 'a' || r > 'z' {
+			return false
+		}
+	}
+	return true
+}
+
+// NormalizeLanguageCode lower cases a language code and trims the spaces around it.
+func NormalizeLanguageCode(code string) string {
+	return strings.ToLower(strings.TrimSpace(code))
+}
+
+// VacancyLanguage is a language the vacancy requires, with the minimum level when it matters.
+type VacancyLanguage struct {
+	ID        uint   `json:"-" gorm:"primaryKey"`
+	VacancyID uint   `json:"-" gorm:"not null;uniqueIndex:idx_vacancy_language"`
+	Language  string `json:"language" gorm:"type:varchar(2);not null;uniqueIndex:idx_vacancy_language" example:"en"`
+	Level     string `json:"level,omitempty" gorm:"type:varchar(10)" example:"b2"`
+}
+
+func (l VacancyLanguage) ValidateLanguage() error {
+	if !IsValidLanguageCode(l.Language) {
+		return errs.ErrInvalidLanguage
+	}
+	if l.Level != "" && !IsValidLanguageLevel(l.Level) {
+		return errs.ErrInvalidLanguageLevel
+	}
+	return nil
+}
+
+// NormalizeLanguages normalizes the codes and levels and keeps the first entry of every language.
+func NormalizeLanguages(languages []VacancyLanguage) []VacancyLanguage {
+	if languages == nil {
+		return nil
+	}
+	seen := make(map[string]bool, len(languages))
+	normalized := make([]VacancyLanguage, 0, len(languages))
+	for _, l := range languages {
+		l.ID, l.VacancyID = 0, 0
+		l.Language = NormalizeLanguageCode(l.Language)
+		l.Level = strings.ToLower(strings.TrimSpace(l.Level))
+		if seen[l.Language] {
+			continue
+		}
+		seen[l.Language] = true
+		normalized = append(normalized, l)
+	}
+	return normalized
+}
@@ -0,0 +1,86 @@
+package models
+
+import (
+	"TajikCareerHub/utils/errs"
+	"net/url"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	InterviewOutcomePending   = "pending"
+	InterviewOutcomePassed    = "passed"
+	InterviewOutcomeFailed    = "failed"
+	InterviewOutcomeNoShow    = "no_show"
+	InterviewOutcomeCancelled = "cancelled"
+)
+
+const (
+	DefaultInterviewTimezone = "Asia/Dushanbe"
+	MaxInterviewDuration     = 8 * time.Hour
+)
+
+type Interview struct {
+	ID            uint        `json:"id" gorm:"primaryKey"`
+	ApplicationID uint        `json:"application_id" gorm:"not null;index"`
+	Application   Application `json:"-" gorm:"foreignKey:ApplicationID"`
+	InterviewerID uint        `json:"interviewer_id" gorm:"not null;index"`
+	Interviewer   User        `json:"interviewer" gorm:"foreignKey:InterviewerID"`
+	CreatedByID   uint        `json:"created_by_id" gorm:"not null"`
+	StartsAt      time.Time   `json:"starts_at" gorm:"not null"`
+	EndsAt        time.Time   `json:"ends_at" gorm:"not null"`
+	Timezone      string      `json:"timezone" gorm:"type:varchar(64);not null"`
+	Location      string      `json:"location" gorm:"type:varchar(255)"`
+	MeetingLink   string      `json:"meeting_link" gorm:"type:varchar(500)"`
+	Outcome       string      `json:"outcome" gorm:"type:varchar(20);not null;default:pending"`
+	Feedback      string      `json:"feedback" gorm:"type:text"`
+	// Sequence is incremented on every change so that calendar clients pick up
+	// rescheduled or cancelled events.
+	Sequence uint `json:"-" gorm:"not null;default:0"`
+	BaseModel
+}
+
+func (i Interview) ValidateInterview() error {
+	if _, err := time.LoadLocation(i.Timezone); err != nil || i.Timezone == "" {
+		return errs.ErrInvalidTimezone
+	}
+	if !i.EndsAt.After(i.StartsAt) || i.EndsAt.Sub(i.StartsAt) > MaxInterviewDuration {
+		return errs.ErrInvalidInterviewTime
+	}
+	if strings.TrimSpace(i.Location) == "" && strings.TrimSpace(i.MeetingLink) == "" {
+		return errs.ErrInterviewPlaceIsRequired
+	}
+	if utf8.RuneCountInString(i.Location) > 255 || len(i.MeetingLink) > 500 {
+		return errs.ErrIncorrectInput
+	}
+	if i.MeetingLink != "" {
+		link, err := url.Parse(i.MeetingLink)
+		if err != nil || (link.Scheme != "http" && link.Scheme != "https") || link.Host == "" {
+			return errs.ErrInvalidMeetingLink
+		}
+	}
+	return nil
+}
+
+func IsValidInterviewOutcome(outcome string) bool {
+	switch outcome {
+	case InterviewOutcomePending, InterviewOutcomePassed, InterviewOutcomeFailed, InterviewOutcomeNoShow, InterviewOutcomeCancelled:
+		return true
+	}
+	return false
+}
+
+type SwagInterview struct {
+	StartsAt        time.Time `json:"starts_at" example:"2024-10-01T10:00:00+05:00"`
+	DurationMinutes int       `json:"duration_minutes" example:"60"`
+	Timezone        string    `json:"timezone" example:"Asia/Dushanbe"`
+	Location        string    `json:"location"`
+	MeetingLink     string    `json:"meeting_link"`
+	InterviewerID   uint      `json:"interviewer_id"`
+}
+
+type SwagInterviewOutcome struct {
+	Outcome  string `json:"outcome" example:"passed"`
+	Feedback string `json:"feedback"`
+}
@@ -0,0 +1,51 @@
+package models
+
+const (
+	LocationTypeRegion   = "region"
+	LocationTypeCity     = "city"
+	LocationTypeDistrict = "district"
+)
+
+const (
+	DefaultLocationSuggestions = 10
+	MaxLocationSuggestions     = 50
+)
+
+// Location is a place in the locations dictionary: a region, a city or a district. Cities
+// belong to a region and districts to a city, so filtering by a region also finds the
+// vacancies and resumes in its cities and districts.
+type Location struct {
+	ID       uint      `json:"id" gorm:"primaryKey"`
+	ParentID *uint     `json:"parent_id,omitempty" gorm:"index"`
+	Parent   *Location `json:"parent,omitempty" gorm:"foreignKey:ParentID"`
+	Type     string    `json:"type" gorm:"type:varchar(20);not null" example:"city"`
+	NameTJ   string    `json:"name_tj" gorm:"type:varchar(100);not null" example:"Хуҷанд"`
+	NameRU   string    `json:"name_ru" gorm:"type:varchar(100);not null" example:"Худжанд"`
+	NameEN   string    `json:"name_en" gorm:"type:varchar(100);not null;uniqueIndex:idx_location_name_en" example:"Khujand"`
+}
+
+// Path returns the IDs of the location and its loaded parents, starting from the location.
+func (l *Location) Path() []uint {
+	var path []uint
+	for location := l; location != nil; location = location.Parent {
+		path = append(path, location.ID)
+	}
+	return path
+}
+
+// LocationQuery narrows the location suggestions. Search matches any part of a name in
+// any language.
+type LocationQuery struct {
+	Search   string
+	ParentID *uint
+	Type     string
+	Limit    int
+}
+
+func IsValidLocationType(locationType string) bool {
+	switch locationType {
+	case LocationTypeRegion, LocationTypeCity, LocationTypeDistrict:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,33 @@
+package models
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestLocationPath(t *testing.T) {
+	region := &Location{ID: 1, Type: LocationTypeRegion}
+	city := &Location{ID: 10, ParentID: &region.ID, Parent: region, Type: LocationTypeCity}
+	district := &Location{ID: 100, ParentID: &city.ID, Parent: city, Type: LocationTypeDistrict}
+	// The parent of a city loaded without its parent is unknown.
+	cityWithoutParent := &Location{ID: 10, ParentID: &region.ID, Type: LocationTypeCity}
+
+	tests := []struct {
+		name     string
+		location *Location
+		want     []uint
+	}{
+		{"no location", nil, nil},
+		{"region", region, []uint{1}},
+		{"city", city, []uint{10, 1}},
+		{"district", district, []uint{100, 10, 1}},
+		{"parent not loaded", cityWithoutParent, []uint{10}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.location.Path(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Path() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,40 @@
+package models
+
+// Weights of the match score criteria, they add up to 100.
+const (
+	MatchWeightCategory   = 20
+	MatchWeightLocation   = 15
+	MatchWeightSalary     = 15
+	MatchWeightExperience = 15
+	MatchWeightSkills     = 25
+	MatchWeightText       = 10
+)
+
+const (
+	MatchCriterionCategory   = "category"
+	MatchCriterionLocation   = "location"
+	MatchCriterionSalary     = "salary"
+	MatchCriterionExperience = "experience"
+	MatchCriterionSkills     = "skills"
+	MatchCriterionText       = "text"
+)
+
+// MatchCandidatesLimit bounds the number of vacancies or resumes scored for a single request.
+// Candidates are preselected by category and skills, the most recently updated first.
+const MatchCandidatesLimit = 500
+
+// Match is a vacancy or resume ranked for the other side with the explanation of its score.
+type Match struct {
+	Score     float64          `json:"score"`
+	Breakdown []ScoreComponent `json:"breakdown"`
+	Vacancy   *Vacancy         `json:"vacancy,omitempty"`
+	Resume    *Resume          `json:"resume,omitempty"`
+}
+
+// ScoreComponent is the part of the score given by one criterion, from 0 to Weight.
+type ScoreComponent struct {
+	Criterion string  `json:"criterion"`
+	Weight    float64 `json:"weight"`
+	Score     float64 `json:"score"`
+	Details   string  `json:"details"`
+}
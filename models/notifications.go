@@ -0,0 +1,20 @@
+package models
+
+import "time"
+
+const (
+	NotificationTypeVacancyExpired = "vacancy_expired"
+)
+
+// Notification is a message to a user about something that happened without their action,
+// such as the expiry of their vacancy.
+type Notification struct {
+	ID        uint       `json:"id" gorm:"primaryKey"`
+	UserID    uint       `json:"-" gorm:"not null;index"`
+	Type      string     `json:"type" gorm:"type:varchar(50);not null"`
+	Title     string     `json:"title" gorm:"not null"`
+	Message   string     `json:"message" gorm:"type:text"`
+	VacancyID *uint      `json:"vacancy_id,omitempty"`
+	ReadAt    *time.Time `json:"read_at,omitempty"`
+	CreatedAt time.Time  `json:"created_at" gorm:"autoCreateTime"`
+}
@@ -0,0 +1,25 @@
+package models
+
+const (
+	DefaultPageSize = 20
+	MaxPageSize     = 100
+)
+
+// PageParams describes the requested slice of a list. When Cursor is set the page is
+// located by keyset pagination from the previous page and Page is ignored.
+type PageParams struct {
+	Page   int
+	Size   int
+	Cursor string
+	Sort   []SortParam
+}
+
+type SortParam struct {
+	Field string
+	Desc  bool
+}
+
+type PageInfo struct {
+	Total      int64
+	NextCursor string
+}
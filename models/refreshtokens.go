@@ -0,0 +1,15 @@
+package models
+
+import "time"
+
+type RefreshToken struct {
+	ID           uint       `json:"id" gorm:"primaryKey"`
+	UserID       uint       `json:"user_id" gorm:"not null;index"`
+	User         User       `json:"-" gorm:"foreignKey:UserID"`
+	TokenHash    string     `json:"-" gorm:"type:varchar(64);unique;not null"`
+	FamilyID     string     `json:"family_id" gorm:"type:varchar(64);not null;index"`
+	ExpiresAt    time.Time  `json:"expires_at" gorm:"not null"`
+	RevokedAt    *time.Time `json:"revoked_at"`
+	ReplacedByID *uint      `json:"replaced_by_id"`
+	BaseModel
+}
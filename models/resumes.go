@@ -5,20 +5,33 @@ import (
 	"strings"
 )
 
+// Resume is a specialist's CV.
+//
+// The structured sections Experience, EducationHistory and SkillTags are the source of truth
+// for the flat ExperienceYears, Education and Skills fields: when a section is sent, the flat
+// field is derived from it. ExpectedSalary is a monthly amount in ExpectedSalaryCurrency.
+// LocationID links the resume to the locations dictionary, Location then holds its name.
 type Resume struct {
-	ID                uint            `json:"id" gorm:"primaryKey;autoIncrement"`
-	Title             string          `json:"title" gorm:"not null"`
-	UserID            uint            `json:"user_id" gorm:"not null"`
-	FullName          string          `json:"full_name" gorm:"type:varchar(255);not null"`
-	Summary           string          `json:"summary" gorm:"type:text"`
-	Skills            string          `json:"skills" gorm:"type:text"`
-	ExperienceYears   uint            `json:"experience_years"`
-	Education         string          `json:"education" gorm:"type:text"`
-	Certifications    string          `json:"certifications" gorm:"type:text"`
-	Location          string          `json:"location" gorm:"type:varchar(255)"`
-	VacancyCategoryID uint            `json:"vacancy_category_id" gorm:"not null"`
-	VacancyCategory   VacancyCategory `gorm:"foreignKey:VacancyCategoryID"`
-	IsBlocked         bool            `json:"-" gorm:"default:false"`
+	ID                     uint               `json:"id" gorm:"primaryKey;autoIncrement"`
+	Title                  string             `json:"title" gorm:"not null"`
+	UserID                 uint               `json:"user_id" gorm:"not null"`
+	FullName               string             `json:"full_name" gorm:"type:varchar(255);not null"`
+	Summary                string             `json:"summary" gorm:"type:text"`
+	Skills                 string             `json:"skills" gorm:"type:text"`
+	ExperienceYears        uint               `json:"experience_years"`
+	Education              string             `json:"education" gorm:"type:text"`
+	Certifications         string             `json:"certifications" gorm:"type:text"`
+	Location               string             `json:"location" gorm:"type:varchar(255)"`
+	LocationID             *uint              `json:"location_id,omitempty" gorm:"index"`
+	LocationDetails        *Location          `json:"location_details,omitempty" gorm:"foreignKey:LocationID"`
+	ExpectedSalary         float64            `json:"expected_salary"`
+	ExpectedSalaryCurrency string             `json:"expected_salary_currency" gorm:"type:varchar(3)"`
+	VacancyCategoryID      uint               `json:"vacancy_category_id" gorm:"not null"`
+	VacancyCategory        VacancyCategory    `gorm:"foreignKey:VacancyCategoryID"`
+	IsBlocked              bool               `json:"-" gorm:"default:false"`
+	Experience             []ResumeExperience `json:"experience,omitempty" gorm:"foreignKey:ResumeID"`
+	EducationHistory       []ResumeEducation  `json:"education_history,omitempty" gorm:"foreignKey:ResumeID"`
+	SkillTags              []ResumeSkill      `json:"skill_tags,omitempty" gorm:"foreignKey:ResumeID"`
 	BaseModel
 }
 
@@ -35,17 +48,58 @@ func (r Resume) ValidateResume() error {
 	if len(r.Summary) > 1000 {
 		return errs.SummaryCannotExceedDefiniteCharacters
 	}
+	if r.ExpectedSalary < 0 {
+		return errs.ErrSalaryMustBeANonNegativeNumber
+	}
+	if r.ExpectedSalaryCurrency != "" && !IsValidCurrency(r.ExpectedSalaryCurrency) {
+		return errs.ErrInvalidCurrency
+	}
+	for _, experience := range r.Experience {
+		if err := experience.ValidateExperience(); err != nil {
+			return err
+		}
+	}
+	for _, education := range r.EducationHistory {
+		if err := education.ValidateEducation(); err != nil {
+			return err
+		}
+	}
+	for _, skill := range r.SkillTags {
+		if err := skill.ValidateSkill(); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
+// SwagResume documents the resume request body. Experience, EducationHistory and SkillTags
+// replace the whole section when present.
 type SwagResume struct {
-	FullName          string `json:"full_name" gorm:"type:varchar(255);not null"`
-	Skills            string `json:"skills" gorm:"type:text"`
-	Summary           string `json:"summary" gorm:"type:text"`
-	ExperienceYears   uint   `json:"experience_years" gorm:"not null"`
-	Location          string `json:"location" gorm:"type:varchar(255)"`
-	VacancyCategoryID uint   `json:"vacancy_category_id" gorm:"not null"`
-	Title             string `json:"title" gorm:"type:varchar(255)"`
+	FullName               string                 `json:"full_name" gorm:"type:varchar(255);not null"`
+	Skills                 string                 `json:"skills" gorm:"type:text"`
+	Summary                string                 `json:"summary" gorm:"type:text"`
+	ExperienceYears        uint                   `json:"experience_years" gorm:"not null"`
+	Location               string                 `json:"location" gorm:"type:varchar(255)"`
+	LocationID             *uint                  `json:"location_id"`
+	ExpectedSalary         float64                `json:"expected_salary"`
+	ExpectedSalaryCurrency string                 `json:"expected_salary_currency" example:"TJS"`
+	VacancyCategoryID      uint                   `json:"vacancy_category_id" gorm:"not null"`
+	Title                  string                 `json:"title" gorm:"type:varchar(255)"`
+	Experience             []SwagResumeExperience `json:"experience"`
+	EducationHistory       []SwagResumeEducation  `json:"education_history"`
+	SkillTags              []SwagResumeSkill      `json:"skill_tags"`
+}
+
+// ResumeFilter narrows the list of resumes. Zero values are ignored.
+type ResumeFilter struct {
+	Search             string
+	MinExperienceYears int
+	// LocationID and Location work as in VacancyFilter.
+	LocationID uint
+	Location   string
+	Category   string
+	// Skills are skill tags or names and aliases from the skills dictionary, all of them are required.
+	Skills []string
 }
 
 type ResumeReport struct {
@@ -0,0 +1,236 @@
+package models
+
+import (
+	"TajikCareerHub/utils/errs"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	SkillProficiencyBeginner     = "beginner"
+	SkillProficiencyIntermediate = "intermediate"
+	SkillProficiencyAdvanced     = "advanced"
+	SkillProficiencyExpert       = "expert"
+)
+
+const MaxSkillNameLength = 100
+
+type ResumeExperience struct {
+	ID          uint      `json:"id" gorm:"primaryKey"`
+	ResumeID    uint      `json:"-" gorm:"not null;index"`
+	CompanyName string    `json:"company_name" gorm:"type:varchar(255);not null"`
+	Title       string    `json:"title" gorm:"type:varchar(255);not null"`
+	StartDate   time.Time `json:"start_date" gorm:"type:date;not null"`
+	// EndDate is empty for the current job.
+	EndDate     *time.Time `json:"end_date" gorm:"type:date"`
+	Description string     `json:"description" gorm:"type:text"`
+}
+
+func (e ResumeExperience) ValidateExperience() error {
+	if strings.TrimSpace(e.CompanyName) == "" || strings.TrimSpace(e.Title) == "" || e.StartDate.IsZero() {
+		return errs.ErrInvalidWorkExperience
+	}
+	if utf8.RuneCountInString(e.CompanyName) > 255 || utf8.RuneCountInString(e.Title) > 255 {
+		return errs.ErrInvalidWorkExperience
+	}
+	if e.StartDate.After(time.Now()) || (e.EndDate != nil && e.EndDate.Before(e.StartDate)) {
+		return errs.ErrInvalidWorkExperience
+	}
+	return nil
+}
+
+type ResumeEducation struct {
+	ID           uint   `json:"id" gorm:"primaryKey"`
+	ResumeID     uint   `json:"-" gorm:"not null;index"`
+	Institution  string `json:"institution" gorm:"type:varchar(255);not null"`
+	Degree       string `json:"degree" gorm:"type:varchar(255)"`
+	FieldOfStudy string `json:"field_of_study" gorm:"type:varchar(255)"`
+	StartYear    uint   `json:"start_year"`
+	// EndYear is empty while still studying.
+	EndYear *uint `json:"end_year"`
+}
+
+func (e ResumeEducation) ValidateEducation() error {
+	if strings.TrimSpace(e.Institution) == "" {
+		return errs.ErrInvalidEducation
+	}
+	if utf8.RuneCountInString(e.Institution) > 255 || utf8.RuneCountInString(e.Degree) > 255 || utf8.RuneCountInString(e.FieldOfStudy) > 255 {
+		return errs.ErrInvalidEducation
+	}
+	if e.StartYear != 0 && e.EndYear != nil && *e.EndYear < e.StartYear {
+		return errs.ErrInvalidEducation
+	}
+	return nil
+}
+
+type ResumeSkill struct {
+	ID          uint   `json:"id" gorm:"primaryKey"`
+	ResumeID    uint   `json:"-" gorm:"not null;uniqueIndex:idx_resume_skill_name"`
+	Name        string `json:"name" gorm:"type:varchar(100);not null;uniqueIndex:idx_resume_skill_name"`
+	Proficiency string `json:"proficiency" gorm:"type:varchar(20)"`
+	// SkillID links the tag to the skills dictionary when its name or one of its aliases matches.
+	SkillID *uint `json:"skill_id,omitempty" gorm:"index"`
+}
+
+func (s ResumeSkill) ValidateSkill() error {
+	if s.Name == "" || utf8.RuneCountInString(s.Name) > MaxSkillNameLength {
+		return errs.ErrInvalidSkill
+	}
+	if s.Proficiency != "" && !IsValidSkillProficiency(s.Proficiency) {
+		return errs.ErrInvalidSkillProficiency
+	}
+	return nil
+}
+
+func IsValidSkillProficiency(proficiency string) bool {
+	switch proficiency {
+	case SkillProficiencyBeginner, SkillProficiencyIntermediate, SkillProficiencyAdvanced, SkillProficiencyExpert:
+		return true
+	}
+	return false
+}
+
+// NormalizeSkillName lowercases the name and collapses whitespace so that "Go  Lang"
+// and "go lang" end up as the same tag.
+func NormalizeSkillName(name string) string {
+	return strings.Join(strings.Fields(strings.ToLower(name)), " ")
+}
+
+// NormalizeSkills normalizes skill names and drops empty and repeated tags, keeping the first one.
+func NormalizeSkills(skills []ResumeSkill) []ResumeSkill {
+	if skills == nil {
+		return nil
+	}
+	seen := make(map[string]bool, len(skills))
+	normalized := make([]ResumeSkill, 0, len(skills))
+	for _, skill := range skills {
+		skill.ID = 0
+		skill.Name = NormalizeSkillName(skill.Name)
+		skill.Proficiency = strings.ToLower(strings.TrimSpace(skill.Proficiency))
+		if skill.Name == "" || seen[skill.Name] {
+			continue
+		}
+		seen[skill.Name] = true
+		normalized = append(normalized, skill)
+	}
+	return normalized
+}
+
+// ParseSkills turns a free text list of skills separated by commas, semicolons or
+// new lines into skill tags.
+func ParseSkills(text string) []ResumeSkill {
+	names := strings.FieldsFunc(text, func(r rune) bool {
+		return r == ',' || r == ';' || r == '\n'
+	})
+	skills := make([]ResumeSkill, 0, len(names))
+	for _, name := range names {
+		skills = append(skills, ResumeSkill{Name: name})
+	}
+	return NormalizeSkills(skills)
+}
+
+// SkillsText derives the flat Skills field: a comma separated list of skill names.
+func SkillsText(skills []ResumeSkill) string {
+	names := make([]string, 0, len(skills))
+	for _, skill := range skills {
+		names = append(names, skill.Name)
+	}
+	return strings.Join(names, ", ")
+}
+
+// EducationText derives the flat Education field, one entry per line,
+// e.g. "Bachelor, Computer Science, Tajik National University (2015-2019)".
+func EducationText(education []ResumeEducation) string {
+	lines := make([]string, 0, len(education))
+	for _, e := range education {
+		parts := make([]string, 0, 3)
+		for _, part := range []string{e.Degree, e.FieldOfStudy, e.Institution} {
+			if part = strings.TrimSpace(part); part != "" {
+				parts = append(parts, part)
+			}
+		}
+		line := strings.Join(parts, ", ")
+		switch {
+		case e.StartYear != 0 && e.EndYear != nil:
+			line += fmt.Sprintf(" (%d-%d)", e.StartYear, *e.EndYear)
+		case e.StartYear != 0:
+			line += fmt.Sprintf(" (%d-present)", e.StartYear)
+		case e.EndYear != nil:
+			line += fmt.Sprintf(" (%d)", *e.EndYear)
+		}
+		lines = append(lines, line)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// ExperienceYears derives the flat ExperienceYears field: the number of full years
+// covered by the work experience entries. Overlapping jobs are counted once.
+func ExperienceYears(experience []ResumeExperience, now time.Time) uint {
+	type period struct{ start, end time.Time }
+	periods := make([]period, 0, len(experience))
+	for _, e := range experience {
+		end := now
+		if e.EndDate != nil && e.EndDate.Before(now) {
+			end = *e.EndDate
+		}
+		if end.After(e.StartDate) {
+			periods = append(periods, period{e.StartDate, end})
+		}
+	}
+	sort.Slice(periods, func(i, j int) bool { return periods[i].start.Before(periods[j].start) })
+
+	// Full calendar years of each period are counted exactly, the days left over from the
+	// periods are added up and counted in years of 365 days.
+	var years int
+	var rest time.Duration
+	addPeriod := func(p period) {
+		n := p.end.Year() - p.start.Year()
+		if p.start.AddDate(n, 0, 0).After(p.end) {
+			n--
+		}
+		years += n
+		rest += p.end.Sub(p.start.AddDate(n, 0, 0))
+	}
+	var current period
+	for i, p := range periods {
+		switch {
+		case i == 0:
+			current = p
+		case !p.start.After(current.end):
+			if p.end.After(current.end) {
+				current.end = p.end
+			}
+		default:
+			addPeriod(current)
+			current = p
+		}
+	}
+	if len(periods) > 0 {
+		addPeriod(current)
+	}
+	return uint(years) + uint(rest.Hours()/24/365)
+}
+
+type SwagResumeExperience struct {
+	CompanyName string     `json:"company_name"`
+	Title       string     `json:"title"`
+	StartDate   time.Time  `json:"start_date" example:"2020-01-01T00:00:00Z"`
+	EndDate     *time.Time `json:"end_date" example:"2023-06-30T00:00:00Z"`
+	Description string     `json:"description"`
+}
+
+type SwagResumeEducation struct {
+	Institution  string `json:"institution"`
+	Degree       string `json:"degree"`
+	FieldOfStudy string `json:"field_of_study"`
+	StartYear    uint   `json:"start_year" example:"2015"`
+	EndYear      *uint  `json:"end_year" example:"2019"`
+}
+
+type SwagResumeSkill struct {
+	Name        string `json:"name" example:"go"`
+	Proficiency string `json:"proficiency" example:"advanced"`
+}
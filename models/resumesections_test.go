@@ -0,0 +1,124 @@
+package models
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func datePtr(year int, month time.Month, day int) *time.Time {
+	d := date(year, month, day)
+	return &d
+}
+
+func TestExperienceYears(t *testing.T) {
+	now := date(2024, time.June, 1)
+	tests := []struct {
+		name       string
+		experience []ResumeExperience
+		want       uint
+	}{
+		{"no experience", nil, 0},
+		{"less than a year", []ResumeExperience{{StartDate: date(2023, time.September, 1), EndDate: datePtr(2024, time.March, 1)}}, 0},
+		{"finished job", []ResumeExperience{{StartDate: date(2018, time.January, 1), EndDate: datePtr(2021, time.January, 1)}}, 3},
+		{"current job", []ResumeExperience{{StartDate: date(2020, time.June, 1)}}, 4},
+		{"end date in the future counts until now", []ResumeExperience{{StartDate: date(2022, time.June, 1), EndDate: datePtr(2030, time.January, 1)}}, 2},
+		{"separate jobs add up", []ResumeExperience{
+			{StartDate: date(2015, time.January, 1), EndDate: datePtr(2017, time.January, 1)},
+			{StartDate: date(2019, time.January, 1), EndDate: datePtr(2022, time.January, 1)},
+		}, 5},
+		{"overlapping jobs count once", []ResumeExperience{
+			{StartDate: date(2016, time.January, 1), EndDate: datePtr(2020, time.January, 1)},
+			{StartDate: date(2018, time.January, 1), EndDate: datePtr(2022, time.January, 1)},
+		}, 6},
+		{"nested job counts once", []ResumeExperience{
+			{StartDate: date(2014, time.January, 1), EndDate: datePtr(2024, time.January, 1)},
+			{StartDate: date(2016, time.January, 1), EndDate: datePtr(2017, time.January, 1)},
+		}, 10},
+		{"unsorted entries", []ResumeExperience{
+			{StartDate: date(2021, time.January, 1), EndDate: datePtr(2023, time.January, 1)},
+			{StartDate: date(2015, time.January, 1), EndDate: datePtr(2018, time.January, 1)},
+		}, 5},
+		{"adjacent jobs", []ResumeExperience{
+			{StartDate: date(2016, time.January, 1), EndDate: datePtr(2018, time.January, 1)},
+			{StartDate: date(2018, time.January, 1), EndDate: datePtr(2020, time.January, 1)},
+		}, 4},
+		{"job ending before it starts is ignored", []ResumeExperience{{StartDate: date(2020, time.January, 1), EndDate: datePtr(2019, time.January, 1)}}, 0},
+		{"job starting in the future is ignored", []ResumeExperience{{StartDate: date(2025, time.January, 1)}}, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ExperienceYears(tt.experience, now); got != tt.want {
+				t.Errorf("ExperienceYears() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNormalizeSkills(t *testing.T) {
+	tests := []struct {
+		name   string
+		skills []ResumeSkill
+		want   []ResumeSkill
+	}{
+		{"nil stays nil", nil, nil},
+		{"empty", []ResumeSkill{}, []ResumeSkill{}},
+		{
+			name:   "names and proficiency are normalized",
+			skills: []ResumeSkill{{ID: 3, Name: "  Go  Lang ", Proficiency: " Expert "}},
+			want:   []ResumeSkill{{Name: "go lang", Proficiency: "expert"}},
+		},
+		{
+			name:   "empty names are dropped",
+			skills: []ResumeSkill{{Name: "  "}, {Name: "SQL"}},
+			want:   []ResumeSkill{{Name: "sql"}},
+		},
+		{
+			name:   "repeated names keep the first tag",
+			skills: []ResumeSkill{{Name: "Docker", Proficiency: "basic"}, {Name: "docker", Proficiency: "expert"}, {Name: "Git"}},
+			want:   []ResumeSkill{{Name: "docker", Proficiency: "basic"}, {Name: "git"}},
+		},
+		{
+			name:   "dictionary link is kept",
+			skills: []ResumeSkill{{Name: "Go", SkillID: uintPtr(7)}},
+			want:   []ResumeSkill{{Name: "go", SkillID: uintPtr(7)}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NormalizeSkills(tt.skills); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NormalizeSkills() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseSkills(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want []ResumeSkill
+	}{
+		{"empty", "", []ResumeSkill{}},
+		{"separators only", " , ;\n", []ResumeSkill{}},
+		{"commas", "Go, PostgreSQL,Docker", []ResumeSkill{{Name: "go"}, {Name: "postgresql"}, {Name: "docker"}}},
+		{"semicolons and new lines", "Go;SQL\nGit\r\n", []ResumeSkill{{Name: "go"}, {Name: "sql"}, {Name: "git"}}},
+		{"repeated skills", "go, Go, GO ", []ResumeSkill{{Name: "go"}}},
+		{"names with spaces", "Machine   Learning, REST API", []ResumeSkill{{Name: "machine learning"}, {Name: "rest api"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ParseSkills(tt.text); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseSkills(%q) = %#v, want %#v", tt.text, got, tt.want)
+			}
+		})
+	}
+}
+
+func uintPtr(v uint) *uint {
+	return &v
+}
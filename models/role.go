@@ -1,6 +1,36 @@
 package models
 
+const (
+	RoleAdmin      = "admin"
+	RoleSpecialist = "specialist"
+	RoleEmployer   = "employer"
+)
+
+const (
+	PermissionUserList          = "user:list"
+	PermissionUserBlock         = "user:block"
+	PermissionUserSessionRevoke = "user:session_revoke"
+	PermissionVacancyWrite      = "vacancy:write"
+	PermissionVacancyBlock      = "vacancy:block"
+	PermissionResumeWrite       = "resume:write"
+	PermissionResumeBlock       = "resume:block"
+	PermissionCompanyWrite      = "company:write"
+	PermissionCompanyVerify     = "company:verify"
+	PermissionCompanyBlock      = "company:block"
+	PermissionCategoryWrite     = "category:write"
+	PermissionSkillWrite        = "skill:write"
+	PermissionApplicationWrite  = "application:write"
+	PermissionApplicationStatus = "application:status"
+	PermissionManageAnyResource = "resource:manage_any"
+)
+
 type Role struct {
+	ID          uint         `json:"id" gorm:"primaryKey"`
+	Name        string       `json:"name" gorm:"type:varchar(100);unique;not null"`
+	Permissions []Permission `json:"permissions,omitempty" gorm:"many2many:role_permissions"`
+}
+
+type Permission struct {
 	ID   uint   `json:"id" gorm:"primaryKey"`
 	Name string `json:"name" gorm:"type:varchar(100);unique;not null"`
 }
@@ -0,0 +1,73 @@
+package models
+
+const (
+	CurrencyTJS = "TJS"
+	CurrencyUSD = "USD"
+	CurrencyRUB = "RUB"
+)
+
+const (
+	SalaryPeriodMonth = "month"
+	SalaryPeriodHour  = "hour"
+)
+
+const (
+	SalaryBasisGross = "gross"
+	SalaryBasisNet   = "net"
+)
+
+const defaultHoursPerMonth = 168
+
+func IsValidCurrency(currency string) bool {
+	switch currency {
+	case CurrencyTJS, CurrencyUSD, CurrencyRUB:
+		return true
+	}
+	return false
+}
+
+func IsValidSalaryPeriod(period string) bool {
+	return period == SalaryPeriodMonth || period == SalaryPeriodHour
+}
+
+func IsValidSalaryBasis(basis string) bool {
+	return basis == SalaryBasisGross || basis == SalaryBasisNet
+}
+
+// ExchangeRates is the configurable table used to compare salaries given in different
+// currencies and pay periods. Rates hold the value of one unit of a currency in
+// BaseCurrency; the base currency itself doesn't need a rate.
+type ExchangeRates struct {
+	BaseCurrency  string             `json:"base_currency"`
+	Rates         map[string]float64 `json:"rates"`
+	HoursPerMonth float64            `json:"hours_per_month"`
+}
+
+// Rate returns the value of one unit of currency in the base currency.
+func (r ExchangeRates) Rate(currency string) (float64, bool) {
+	if currency == r.BaseCurrency {
+		return 1, true
+	}
+	rate, ok := r.Rates[currency]
+	return rate, ok && rate > 0
+}
+
+// PeriodFactor returns the multiplier converting an amount paid per period to a monthly amount.
+func (r ExchangeRates) PeriodFactor(period string) float64 {
+	if period != SalaryPeriodHour {
+		return 1
+	}
+	if r.HoursPerMonth > 0 {
+		return r.HoursPerMonth
+	}
+	return defaultHoursPerMonth
+}
+
+// ToMonthlyBase converts an amount to a monthly amount in the base currency.
+func (r ExchangeRates) ToMonthlyBase(amount float64, currency string, period string) (float64, bool) {
+	rate, ok := r.Rate(currency)
+	if !ok {
+		return 0, false
+	}
+	return amount * rate * r.PeriodFactor(period), true
+}
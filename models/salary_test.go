@@ -0,0 +1,40 @@
+package models
+
+import "testing"
+
+func TestExchangeRatesToMonthlyBase(t *testing.T) {
+	rates := ExchangeRates{
+		BaseCurrency:  CurrencyTJS,
+		Rates:         map[string]float64{CurrencyUSD: 10.5, CurrencyRUB: 0.12, "EUR": 0},
+		HoursPerMonth: 160,
+	}
+	tests := []struct {
+		name     string
+		rates    ExchangeRates
+		amount   float64
+		currency string
+		period   string
+		want     float64
+		wantOK   bool
+	}{
+		{"base currency", rates, 5000, CurrencyTJS, SalaryPeriodMonth, 5000, true},
+		{"converted currency", rates, 1000, CurrencyUSD, SalaryPeriodMonth, 10500, true},
+		{"hourly amount", rates, 50, CurrencyTJS, SalaryPeriodHour, 8000, true},
+		{"hourly amount in another currency", rates, 5, CurrencyUSD, SalaryPeriodHour, 8400, true},
+		{"empty period is monthly", rates, 5000, CurrencyTJS, "", 5000, true},
+		{"default hours per month", ExchangeRates{BaseCurrency: CurrencyTJS}, 10, CurrencyTJS, SalaryPeriodHour, 10 * defaultHoursPerMonth, true},
+		{"base currency without a rate table", ExchangeRates{BaseCurrency: CurrencyUSD}, 100, CurrencyUSD, SalaryPeriodMonth, 100, true},
+		{"zero amount", rates, 0, CurrencyUSD, SalaryPeriodMonth, 0, true},
+		{"currency without a rate", rates, 100, "KZT", SalaryPeriodMonth, 0, false},
+		{"zero rate", rates, 100, "EUR", SalaryPeriodMonth, 0, false},
+		{"empty currency", rates, 100, "", SalaryPeriodMonth, 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := tt.rates.ToMonthlyBase(tt.amount, tt.currency, tt.period)
+			if got != tt.want || ok != tt.wantOK {
+				t.Errorf("ToMonthlyBase(%v, %q, %q) = %v, %v, want %v, %v", tt.amount, tt.currency, tt.period, got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
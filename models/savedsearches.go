@@ -0,0 +1,127 @@
+package models
+
+import (
+	"TajikCareerHub/utils/errs"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	SavedSearchFrequencyDaily  = "daily"
+	SavedSearchFrequencyWeekly = "weekly"
+)
+
+const (
+	MaxSavedSearchesPerUser   = 20
+	MaxSavedSearchNameLength  = 100
+	DefaultVacanciesPerDigest = 20
+)
+
+func IsValidSavedSearchFrequency(frequency string) bool {
+	return frequency == SavedSearchFrequencyDaily || frequency == SavedSearchFrequencyWeekly
+}
+
+// SavedSearchPeriod returns how long to wait between two digests of a saved search.
+func SavedSearchPeriod(frequency string) time.Duration {
+	if frequency == SavedSearchFrequencyWeekly {
+		return 7 * 24 * time.Hour
+	}
+	return 24 * time.Hour
+}
+
+// SavedSearch is a vacancy search a user runs again and again. The scheduler sends the user
+// a digest of the vacancies published since LastRunAt that match Filter.
+type SavedSearch struct {
+	ID        uint              `json:"id" gorm:"primaryKey"`
+	UserID    uint              `json:"-" gorm:"not null;index"`
+	User      User              `json:"-" gorm:"foreignKey:UserID"`
+	Name      string            `json:"name" gorm:"type:varchar(100);not null"`
+	Filter    SavedSearchFilter `json:"filter" gorm:"type:jsonb;serializer:json;not null"`
+	Frequency string            `json:"frequency" gorm:"type:varchar(10);not null;default:daily"`
+	IsActive  bool              `json:"is_active" gorm:"not null"`
+	LastRunAt time.Time         `json:"last_run_at" gorm:"not null"`
+	CreatedAt time.Time         `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt time.Time         `json:"-" gorm:"autoUpdateTime"`
+	DeletedAt bool              `json:"-" gorm:"default:false"`
+}
+
+// SavedSearchFilter holds the /vacancies query parameters of a saved search.
+type SavedSearchFilter struct {
+	Search          string   `json:"search,omitempty"`
+	MinSalary       float64  `json:"min_salary,omitempty"`
+	MaxSalary       float64  `json:"max_salary,omitempty"`
+	Currency        string   `json:"currency,omitempty"`
+	LocationID      uint     `json:"location_id,omitempty"`
+	Location        string   `json:"location,omitempty"`
+	Category        string   `json:"category,omitempty"`
+	Skills          []string `json:"skills,omitempty"`
+	EmploymentTypes []string `json:"employment_types,omitempty"`
+	WorkSchedules   []string `json:"work_schedules,omitempty"`
+	RemoteModes     []string `json:"remote_modes,omitempty"`
+	ExperienceYears *uint    `json:"experience_years,omitempty"`
+	Languages       []string `json:"languages,omitempty"`
+}
+
+// VacancyFilter converts the saved parameters to the filter of the vacancy list.
+func (f SavedSearchFilter) VacancyFilter() VacancyFilter {
+	return VacancyFilter{
+		Search:          strings.TrimSpace(f.Search),
+		MinSalary:       f.MinSalary,
+		MaxSalary:       f.MaxSalary,
+		SalaryCurrency:  strings.ToUpper(f.Currency),
+		LocationID:      f.LocationID,
+		Location:        strings.TrimSpace(f.Location),
+		Category:        strings.TrimSpace(f.Category),
+		Skills:          normalizeList(f.Skills, NormalizeSkillName),
+		EmploymentTypes: normalizeList(f.EmploymentTypes, strings.ToLower),
+		WorkSchedules:   normalizeList(f.WorkSchedules, strings.ToLower),
+		RemoteModes:     normalizeList(f.RemoteModes, strings.ToLower),
+		ExperienceYears: f.ExperienceYears,
+		Languages:       normalizeList(f.Languages, NormalizeLanguageCode),
+	}
+}
+
+func normalizeList(values []string, normalize func(string) string) (normalized []string) {
+	for _, value := range values {
+		if value = normalize(strings.TrimSpace(value)); value != "" {
+			normalized = append(normalized, value)
+		}
+	}
+	return normalized
+}
+
+func (s SavedSearch) ValidateSavedSearch() error {
+	if strings.TrimSpace(s.Name) == "" {
+		return errs.ErrSavedSearchNameIsRequired
+	}
+	if utf8.RuneCountInString(s.Name) > MaxSavedSearchNameLength {
+		return errs.ErrSavedSearchNameTooLong
+	}
+	if !IsValidSavedSearchFrequency(s.Frequency) {
+		return errs.ErrInvalidSavedSearchFrequency
+	}
+	filter := s.Filter.VacancyFilter()
+	if filter.MinSalary < 0 || filter.MaxSalary < 0 {
+		return errs.ErrSalaryMustBeANonNegativeNumber
+	}
+	if filter.SalaryCurrency != "" && !IsValidCurrency(filter.SalaryCurrency) {
+		return errs.ErrInvalidCurrency
+	}
+	return filter.ValidateVacancyFilter()
+}
+
+type SwagSavedSearch struct {
+	Name      string            `json:"name" example:"Go developer in Dushanbe"`
+	Filter    SavedSearchFilter `json:"filter"`
+	Frequency string            `json:"frequency" example:"daily"`
+	IsActive  *bool             `json:"is_active"`
+}
+
+// SavedSearchDigest is a message about the new vacancies of a saved search. Vacancies holds
+// the newest of them, Total counts them all.
+type SavedSearchDigest struct {
+	Search    SavedSearch
+	Vacancies []Vacancy
+	Total     int64
+}
@@ -0,0 +1,86 @@
+package models
+
+import (
+	"TajikCareerHub/utils/errs"
+	"encoding/json"
+	"unicode/utf8"
+)
+
+// Skill is an entry of the skills dictionary shared by vacancies and resumes. Name is the
+// canonical normalized name used for matching, the localized names are for display.
+type Skill struct {
+	ID      uint         `json:"id" gorm:"primaryKey"`
+	Name    string       `json:"name" gorm:"type:varchar(100);not null;uniqueIndex:idx_skill_name,where:deleted_at = false"`
+	NameTJ  string       `json:"name_tj" gorm:"type:varchar(100)"`
+	NameRU  string       `json:"name_ru" gorm:"type:varchar(100)"`
+	NameEN  string       `json:"name_en" gorm:"type:varchar(100)"`
+	Aliases []SkillAlias `json:"aliases" gorm:"foreignKey:SkillID" swaggertype:"array,string"`
+	BaseModel
+}
+
+// SkillAlias is another name the skill is known by, e.g. "golang" for "go".
+type SkillAlias struct {
+	ID      uint   `json:"-" gorm:"primaryKey"`
+	SkillID uint   `json:"-" gorm:"not null;index"`
+	Alias   string `json:"-" gorm:"type:varchar(100);not null;uniqueIndex"`
+}
+
+// SkillAlias is encoded in JSON as a plain string.
+func (a SkillAlias) MarshalJSON() ([]byte, error) {
+	return json.Marshal(a.Alias)
+}
+
+func (a *SkillAlias) UnmarshalJSON(data []byte) error {
+	return json.Unmarshal(data, &a.Alias)
+}
+
+// Normalize brings the name and aliases to the form they are matched in and drops
+// empty aliases and aliases repeating the name.
+func (s *Skill) Normalize() {
+	s.Name = NormalizeSkillName(s.Name)
+	seen := map[string]bool{s.Name: true}
+	aliases := make([]SkillAlias, 0, len(s.Aliases))
+	for _, alias := range s.Aliases {
+		alias.Alias = NormalizeSkillName(alias.Alias)
+		if alias.Alias == "" || seen[alias.Alias] {
+			continue
+		}
+		seen[alias.Alias] = true
+		aliases = append(aliases, SkillAlias{SkillID: s.ID, Alias: alias.Alias})
+	}
+	s.Aliases = aliases
+}
+
+func (s Skill) ValidateSkill() error {
+	if s.Name == "" || utf8.RuneCountInString(s.Name) > MaxSkillNameLength {
+		return errs.ErrInvalidSkill
+	}
+	for _, name := range []string{s.NameTJ, s.NameRU, s.NameEN} {
+		if utf8.RuneCountInString(name) > MaxSkillNameLength {
+			return errs.ErrInvalidSkill
+		}
+	}
+	for _, alias := range s.Aliases {
+		if utf8.RuneCountInString(alias.Alias) > MaxSkillNameLength {
+			return errs.ErrInvalidSkill
+		}
+	}
+	return nil
+}
+
+// SkillNames returns the canonical name followed by the aliases.
+func (s Skill) SkillNames() []string {
+	names := []string{s.Name}
+	for _, alias := range s.Aliases {
+		names = append(names, alias.Alias)
+	}
+	return names
+}
+
+type SwagSkill struct {
+	Name    string   `json:"name" example:"go"`
+	NameTJ  string   `json:"name_tj" example:"Go"`
+	NameRU  string   `json:"name_ru" example:"Go"`
+	NameEN  string   `json:"name_en" example:"Go"`
+	Aliases []string `json:"aliases" example:"golang"`
+}
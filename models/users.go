@@ -7,15 +7,16 @@ import (
 )
 
 type User struct {
-	ID        uint      `json:"id" gorm:"primaryKey"`
-	FullName  string    `json:"full_name" gorm:"type:varchar(255);not null"`
-	UserName  string    `json:"username" gorm:"type:varchar(100);unique;not null"`
-	BirthDate time.Time `json:"birth_date" gorm:"type:date"`
-	Email     string    `json:"email" gorm:"type:varchar(100);unique;not null"`
-	Password  string    `json:"password" gorm:"type:varchar(255);not null"`
-	RoleID    uint      `json:"role_id" gorm:"not null"`
-	Role      Role      `json:"role" gorm:"foreignKey:RoleID"`
-	IsBlocked bool      `json:"-" gorm:"type:bool;not null;default:false"`
+	ID           uint      `json:"id" gorm:"primaryKey"`
+	FullName     string    `json:"full_name" gorm:"type:varchar(255);not null"`
+	UserName     string    `json:"username" gorm:"type:varchar(100);unique;not null"`
+	BirthDate    time.Time `json:"birth_date" gorm:"type:date"`
+	Email        string    `json:"email" gorm:"type:varchar(100);unique;not null"`
+	Password     string    `json:"password" gorm:"type:varchar(255);not null"`
+	RoleID       uint      `json:"role_id" gorm:"not null"`
+	Role         Role      `json:"role" gorm:"foreignKey:RoleID"`
+	IsBlocked    bool      `json:"-" gorm:"type:bool;not null;default:false"`
+	TokenVersion uint      `json:"-" gorm:"not null;default:0"`
 	BaseModel
 }
 
@@ -35,14 +36,6 @@ func (u User) ValidateCredentials() (err error) {
 	if len(u.Password) < 8 {
 		return errs.ErrIncorrectPasswordLength
 	}
-
-	switch u.RoleID {
-	case 1:
-		return errs.ErrRoleCannotBeAdmin
-	case 2, 3:
-	default:
-		return errs.ErrInvalidRole
-	}
 	return nil
 }
 
@@ -2,23 +2,97 @@ package models
 
 import (
 	"TajikCareerHub/utils/errs"
+	"time"
 	"unicode/utf8"
 )
 
+const (
+	VacancyStatusDraft     = "draft"
+	VacancyStatusPublished = "published"
+	VacancyStatusPaused    = "paused"
+	VacancyStatusExpired   = "expired"
+	VacancyStatusClosed    = "closed"
+	VacancyStatusFilled    = "filled"
+)
+
+// vacancyStatusTransitions holds the statuses reachable from each vacancy status.
+// Closed and filled are terminal, expired vacancies can be published again.
+var vacancyStatusTransitions = map[string][]string{
+	VacancyStatusDraft:     {VacancyStatusPublished, VacancyStatusClosed},
+	VacancyStatusPublished: {VacancyStatusPaused, VacancyStatusExpired, VacancyStatusClosed, VacancyStatusFilled},
+	VacancyStatusPaused:    {VacancyStatusPublished, VacancyStatusClosed, VacancyStatusFilled},
+	VacancyStatusExpired:   {VacancyStatusPublished, VacancyStatusClosed, VacancyStatusFilled},
+}
+
+func IsValidVacancyStatus(status string) bool {
+	switch status {
+	case VacancyStatusDraft, VacancyStatusPublished, VacancyStatusPaused,
+		VacancyStatusExpired, VacancyStatusClosed, VacancyStatusFilled:
+		return true
+	}
+	return false
+}
+
+func CanTransitionVacancyStatus(from, to string) bool {
+	for _, status := range vacancyStatusTransitions[from] {
+		if status == to {
+			return true
+		}
+	}
+	return false
+}
+
+// Vacancy is a job offer of a company.
+//
+// The salary is a range of SalaryMin and SalaryMax, one of them is enough for "from" and
+// "up to" salaries, and a negotiable salary has no amounts. SalaryHidden hides the amounts
+// from everyone but the employer, they are still used by the salary filter and matching.
+// SkillIDs replaces the vacancy's skills when sent on create or update, and so do Languages.
+// The experience range is in years, MaxExperienceYears is nil when there is no upper limit.
+// LocationID links the vacancy to the locations dictionary, Location then holds its name.
+//
+// Only published vacancies that haven't reached ExpiresAt are listed and accept applications.
+// The status is changed by the publish, pause and close actions and by the expiry job, never
+// by a vacancy update.
 type Vacancy struct {
-	ID                uint            `gorm:"primaryKey"`
-	Title             string          `json:"title"`
-	Description       string          `json:"description"`
-	Location          string          `json:"location"`
-	Salary            float64         `json:"salary"`
-	CompanyID         uint            `json:"company_id"`
-	Company           Company         `gorm:"foreignKey:CompanyID"`
-	User              User            `gorm:"foreignKey:UserID"`
-	UserID            uint            `json:"user_id"`
-	VacancyCategoryID uint            `json:"vacancy_category_id"`
-	VacancyCategory   VacancyCategory `gorm:"foreignKey:VacancyCategoryID"`
-	IsBlocked         bool            `json:"-" gorm:"default:false"`
-	VacancyViews      []VacancyView   `gorm:"foreignKey:VacancyID"`
+	ID                 uint              `gorm:"primaryKey"`
+	Title              string            `json:"title"`
+	Description        string            `json:"description"`
+	Location           string            `json:"location"`
+	LocationID         *uint             `json:"location_id,omitempty" gorm:"index"`
+	LocationDetails    *Location         `json:"location_details,omitempty" gorm:"foreignKey:LocationID"`
+	SalaryMin          float64           `json:"salary_min"`
+	SalaryMax          float64           `json:"salary_max"`
+	Currency           string            `json:"currency" gorm:"type:varchar(3);not null;default:TJS"`
+	SalaryPeriod       string            `json:"salary_period" gorm:"type:varchar(10);not null;default:month"`
+	SalaryBasis        string            `json:"salary_basis" gorm:"type:varchar(10);not null;default:gross"`
+	SalaryNegotiable   bool              `json:"salary_negotiable" gorm:"default:false"`
+	SalaryHidden       bool              `json:"salary_hidden" gorm:"default:false"`
+	EmploymentType     string            `json:"employment_type" gorm:"type:varchar(20);not null;default:full_time;index"`
+	WorkSchedule       string            `json:"work_schedule" gorm:"type:varchar(20);not null;default:full_day"`
+	RemoteMode         string            `json:"remote_mode" gorm:"type:varchar(10);not null;default:onsite;index"`
+	MinExperienceYears uint              `json:"min_experience_years"`
+	MaxExperienceYears *uint             `json:"max_experience_years,omitempty"`
+	Languages          []VacancyLanguage `json:"languages,omitempty" gorm:"foreignKey:VacancyID"`
+	CompanyID          uint              `json:"company_id"`
+	Company            Company           `gorm:"foreignKey:CompanyID"`
+	User               User              `gorm:"foreignKey:UserID"`
+	UserID             uint              `json:"user_id"`
+	VacancyCategoryID  uint              `json:"vacancy_category_id"`
+	VacancyCategory    VacancyCategory   `gorm:"foreignKey:VacancyCategoryID"`
+	Status             string            `json:"status" gorm:"type:varchar(20);not null;default:draft;index"`
+	PublishedAt        *time.Time        `json:"published_at,omitempty"`
+	LastPublishedAt    *time.Time        `json:"-" gorm:"index"`
+	ExpiresAt          *time.Time        `json:"expires_at,omitempty"`
+	ClosedAt           *time.Time        `json:"closed_at,omitempty"`
+	IsBlocked          bool              `json:"-" gorm:"default:false"`
+	VacancyViews       []VacancyView     `gorm:"foreignKey:VacancyID"`
+	Skills             []Skill           `json:"skills,omitempty" gorm:"many2many:vacancy_skills"`
+	SkillIDs           []uint            `json:"skill_ids,omitempty" gorm:"-"`
+	MonthlySalary      float64           `json:"-" gorm:"->;-:migration"`
+	Rank               float64           `json:"rank,omitempty" gorm:"->;-:migration"`
+	TitleHighlight     string            `json:"title_highlight,omitempty" gorm:"->;-:migration"`
+	Highlight          string            `json:"highlight,omitempty" gorm:"->;-:migration"`
 	BaseModel
 }
 
@@ -35,8 +109,11 @@ func (v Vacancy) ValidateVacancy() error {
 	if utf8.RuneCountInString(v.Description) > 1000 {
 		return errs.ErrDescriptionMustBeLessThanDefiniteCharacters
 	}
-	if v.Salary < 0 {
-		return errs.ErrSalaryMustBeANonNegativeNumber
+	if err := v.validateSalary(); err != nil {
+		return err
+	}
+	if err := v.validateEmployment(); err != nil {
+		return err
 	}
 	if v.CompanyID == 0 {
 		return errs.ErrCompanyIDIsRequired
@@ -47,6 +124,124 @@ func (v Vacancy) ValidateVacancy() error {
 	return nil
 }
 
+// IsOpen reports whether the vacancy is published and not expired at the given time.
+func (v Vacancy) IsOpen(now time.Time) bool {
+	return v.Status == VacancyStatusPublished && (v.ExpiresAt == nil || v.ExpiresAt.After(now))
+}
+
+func (v Vacancy) validateSalary() error {
+	if v.SalaryMin < 0 || v.SalaryMax < 0 {
+		return errs.ErrSalaryMustBeANonNegativeNumber
+	}
+	if v.SalaryMax > 0 && v.SalaryMin > v.SalaryMax {
+		return errs.ErrInvalidSalaryRange
+	}
+	if v.SalaryNegotiable && (v.SalaryMin > 0 || v.SalaryMax > 0) {
+		return errs.ErrInvalidSalaryRange
+	}
+	if !IsValidCurrency(v.Currency) {
+		return errs.ErrInvalidCurrency
+	}
+	if !IsValidSalaryPeriod(v.SalaryPeriod) {
+		return errs.ErrInvalidSalaryPeriod
+	}
+	if !IsValidSalaryBasis(v.SalaryBasis) {
+		return errs.ErrInvalidSalaryBasis
+	}
+	return nil
+}
+
+func (v Vacancy) validateEmployment() error {
+	if !IsValidEmploymentType(v.EmploymentType) {
+		return errs.ErrInvalidEmploymentType
+	}
+	if !IsValidWorkSchedule(v.WorkSchedule) {
+		return errs.ErrInvalidWorkSchedule
+	}
+	if !IsValidRemoteMode(v.RemoteMode) {
+		return errs.ErrInvalidRemoteMode
+	}
+	if v.MaxExperienceYears != nil && (*v.MaxExperienceYears < v.MinExperienceYears || *v.MaxExperienceYears > ExperienceYearsLimit) {
+		return errs.ErrInvalidExperienceRange
+	}
+	if v.MinExperienceYears > ExperienceYearsLimit {
+		return errs.ErrInvalidExperienceRange
+	}
+	for _, language := range v.Languages {
+		if err := language.ValidateLanguage(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SetEmploymentDefaults fills in the employment type, schedule and remote mode left empty.
+func (v *Vacancy) SetEmploymentDefaults() {
+	if v.EmploymentType == "" {
+		v.EmploymentType = EmploymentTypeFullTime
+	}
+	if v.WorkSchedule == "" {
+		v.WorkSchedule = WorkScheduleFullDay
+	}
+	if v.RemoteMode == "" {
+		v.RemoteMode = RemoteModeOnsite
+	}
+}
+
+// HasSalary reports whether any of the salary fields was sent.
+func (v Vacancy) HasSalary() bool {
+	return v.SalaryMin != 0 || v.SalaryMax != 0 || v.Currency != "" || v.SalaryPeriod != "" ||
+		v.SalaryBasis != "" || v.SalaryNegotiable || v.SalaryHidden
+}
+
+// SalaryViewer tells whose hidden salaries a user sees: those of the vacancies the user
+// wrote or that belong to the companies in CompanyIDs, or every one when All is set.
+type SalaryViewer struct {
+	UserID     uint
+	CompanyIDs []uint
+	All        bool
+}
+
+// CanSeeSalary reports whether the viewer sees the salary amounts of the vacancy.
+func (s SalaryViewer) CanSeeSalary(v Vacancy) bool {
+	if !v.SalaryHidden || s.All || v.UserID == s.UserID {
+		return true
+	}
+	for _, companyID := range s.CompanyIDs {
+		if companyID == v.CompanyID {
+			return true
+		}
+	}
+	return false
+}
+
+// HasExperience reports whether any bound of the experience range was sent.
+func (v Vacancy) HasExperience() bool {
+	return v.MinExperienceYears != 0 || v.MaxExperienceYears != nil
+}
+
+// SetSalaryDefaults fills in the currency, period and basis left empty.
+func (v *Vacancy) SetSalaryDefaults() {
+	if v.Currency == "" {
+		v.Currency = CurrencyTJS
+	}
+	if v.SalaryPeriod == "" {
+		v.SalaryPeriod = SalaryPeriodMonth
+	}
+	if v.SalaryBasis == "" {
+		v.SalaryBasis = SalaryBasisGross
+	}
+}
+
+// SalaryUpperBound returns the most the vacancy offers: the maximum of the range, or the
+// minimum for "from" salaries. It is 0 for negotiable salaries.
+func (v Vacancy) SalaryUpperBound() float64 {
+	if v.SalaryMax > 0 {
+		return v.SalaryMax
+	}
+	return v.SalaryMin
+}
+
 type VacancyReport struct {
 	VacancyID         uint   `json:"vacancy_id"`
 	VacancyTitle      string `json:"vacancy_title"`
@@ -55,12 +250,93 @@ type VacancyReport struct {
 }
 
 type SwagVacancy struct {
-	Title             string  `json:"title"`
-	Description       string  `json:"description"`
-	Location          string  `json:"location"`
-	Salary            float64 `json:"salary"`
-	CompanyID         uint    `json:"company_id"`
-	VacancyCategoryID uint    `json:"vacancy_category_id"`
+	Title              string            `json:"title"`
+	Description        string            `json:"description"`
+	Location           string            `json:"location"`
+	LocationID         *uint             `json:"location_id"`
+	SalaryMin          float64           `json:"salary_min"`
+	SalaryMax          float64           `json:"salary_max"`
+	Currency           string            `json:"currency" example:"TJS"`
+	SalaryPeriod       string            `json:"salary_period" example:"month"`
+	SalaryBasis        string            `json:"salary_basis" example:"gross"`
+	SalaryNegotiable   bool              `json:"salary_negotiable"`
+	SalaryHidden       bool              `json:"salary_hidden"`
+	EmploymentType     string            `json:"employment_type" example:"full_time"`
+	WorkSchedule       string            `json:"work_schedule" example:"full_day"`
+	RemoteMode         string            `json:"remote_mode" example:"hybrid"`
+	MinExperienceYears uint              `json:"min_experience_years"`
+	MaxExperienceYears *uint             `json:"max_experience_years"`
+	Languages          []VacancyLanguage `json:"languages"`
+	CompanyID          uint              `json:"company_id"`
+	VacancyCategoryID  uint              `json:"vacancy_category_id"`
+	SkillIDs           []uint            `json:"skill_ids"`
+	// Status of a new vacancy is draft or published, drafts are published later.
+	Status    string     `json:"status" example:"draft"`
+	ExpiresAt *time.Time `json:"expires_at"`
+}
+
+// SwagVacancyPublish sets when a published vacancy expires. Without ExpiresAt a new expiry
+// date is set by the configured lifetime, a paused vacancy keeps the date it had.
+type SwagVacancyPublish struct {
+	ExpiresAt *time.Time `json:"expires_at"`
+}
+
+type SwagVacancyClose struct {
+	Status string `json:"status" example:"filled"`
+}
+
+// VacancyFilter narrows the list of vacancies. Zero values are ignored.
+type VacancyFilter struct {
+	Search string
+	// MinSalary and MaxSalary are monthly amounts in SalaryCurrency. Vacancies in other
+	// currencies and hourly rates are converted with the configured exchange rates.
+	MinSalary      float64
+	MaxSalary      float64
+	SalaryCurrency string
+	// LocationID and Location select a location from the dictionary by ID or by name in any
+	// language, vacancies in the places inside it match too. Location also matches the text
+	// of vacancies that aren't linked to the dictionary.
+	LocationID uint
+	Location   string
+	Category   string
+	// Skills are names or aliases from the skills dictionary, all of them are required.
+	Skills []string
+	// EmploymentTypes, WorkSchedules and RemoteModes match any of the listed values.
+	EmploymentTypes []string
+	WorkSchedules   []string
+	RemoteModes     []string
+	// ExperienceYears keeps the vacancies whose experience range includes the given years.
+	ExperienceYears *uint
+	// Languages are language codes, all of them are required.
+	Languages []string
+	// PublishedAfter and PublishedBefore keep the vacancies last published after the one
+	// time and no later than the other, so a vacancy published again is listed as new.
+	PublishedAfter  *time.Time
+	PublishedBefore *time.Time
+}
+
+func (f VacancyFilter) ValidateVacancyFilter() error {
+	for _, employmentType := range f.EmploymentTypes {
+		if !IsValidEmploymentType(employmentType) {
+			return errs.ErrInvalidEmploymentType
+		}
+	}
+	for _, schedule := range f.WorkSchedules {
+		if !IsValidWorkSchedule(schedule) {
+			return errs.ErrInvalidWorkSchedule
+		}
+	}
+	for _, mode := range f.RemoteModes {
+		if !IsValidRemoteMode(mode) {
+			return errs.ErrInvalidRemoteMode
+		}
+	}
+	for _, language := range f.Languages {
+		if !IsValidLanguageCode(language) {
+			return errs.ErrInvalidLanguage
+		}
+	}
+	return nil
 }
 
 type VacancyView struct {
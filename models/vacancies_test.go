@@ -0,0 +1,27 @@
+package models
+
+import "testing"
+
+func TestSalaryViewerCanSeeSalary(t *testing.T) {
+	hidden := Vacancy{UserID: 1, CompanyID: 5, SalaryHidden: true}
+	tests := []struct {
+		name    string
+		viewer  SalaryViewer
+		vacancy Vacancy
+		want    bool
+	}{
+		{"shown salary", SalaryViewer{UserID: 2}, Vacancy{UserID: 1, CompanyID: 5}, true},
+		{"author", SalaryViewer{UserID: 1}, hidden, true},
+		{"company member", SalaryViewer{UserID: 2, CompanyIDs: []uint{4, 5}}, hidden, true},
+		{"admin", SalaryViewer{UserID: 2, All: true}, hidden, true},
+		{"member of another company", SalaryViewer{UserID: 2, CompanyIDs: []uint{4}}, hidden, false},
+		{"anyone else", SalaryViewer{UserID: 2}, hidden, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.viewer.CanSeeSalary(tt.vacancy); got != tt.want {
+				t.Errorf("CanSeeSalary() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
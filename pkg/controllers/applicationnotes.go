@@ -0,0 +1,177 @@
+package controllers
+
+import (
+	"TajikCareerHub/logger"
+	"TajikCareerHub/models"
+	"TajikCareerHub/pkg/service"
+	"TajikCareerHub/utils/errs"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"strconv"
+)
+
+// GetApplicationNotes godoc
+// @Summary Get application notes
+// @Description Get the private notes left on an application. Available to the vacancy author and members of its company.
+// @Tags Applications
+// @Accept json
+// @Produce json
+// @Param application_id path integer true "Application ID"
+// @Success 200 {array} models.ApplicationNote
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /applications/{application_id}/notes [get]
+// @Security ApiKeyAuth
+func GetApplicationNotes(c *gin.Context) {
+	ip := c.ClientIP()
+	idStr := c.Param("application_id")
+	logger.Info.Printf("[controllers.GetApplicationNotes] Client IP: %s - Request to get notes of application %s\n", ip, idStr)
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		handleError(c, errs.ErrIDIsNotCorrect)
+		return
+	}
+	userID, err := service.GetUserIDFromToken(c)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	notes, err := service.GetApplicationNotes(userID, uint(id))
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+	logger.Info.Printf("[controllers.GetApplicationNotes] Client IP: %s - Successfully retrieved notes of application %v\n", ip, id)
+	c.JSON(http.StatusOK, notes)
+}
+
+// AddApplicationNote godoc
+// @Summary Add a note to an application
+// @Description Leave a private note on an application. The applicant never sees the notes.
+// @Tags Applications
+// @Accept json
+// @Produce json
+// @Param application_id path integer true "Application ID"
+// @Param note body models.SwagApplicationNote true "Note"
+// @Success 201 {object} DefaultResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /applications/{application_id}/notes [post]
+// @Security ApiKeyAuth
+func AddApplicationNote(c *gin.Context) {
+	ip := c.ClientIP()
+	idStr := c.Param("application_id")
+	logger.Info.Printf("[controllers.AddApplicationNote] Client IP: %s - Request to add note to application %s\n", ip, idStr)
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		handleError(c, errs.ErrIDIsNotCorrect)
+		return
+	}
+	var note models.SwagApplicationNote
+	if err := c.ShouldBindJSON(&note); err != nil {
+		logger.Error.Printf("[controllers.AddApplicationNote] Client IP: %s - Error parsing note: %v\n", ip, err)
+		handleError(c, errs.ErrShouldBindJson)
+		return
+	}
+	userID, err := service.GetUserIDFromToken(c)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	if err := service.AddApplicationNote(userID, uint(id), note.Text); err != nil {
+		handleError(c, err)
+		return
+	}
+	logger.Info.Printf("[controllers.AddApplicationNote] Client IP: %s - Successfully added note to application %v\n", ip, id)
+	c.JSON(http.StatusCreated, NewDefaultResponse("Note added successfully"))
+}
+
+// DeleteApplicationNote godoc
+// @Summary Delete an application note
+// @Description Delete a note. Only its author can delete it.
+// @Tags Applications
+// @Accept json
+// @Produce json
+// @Param application_id path integer true "Application ID"
+// @Param note_id path integer true "Note ID"
+// @Success 200 {object} DefaultResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /applications/{application_id}/notes/{note_id} [delete]
+// @Security ApiKeyAuth
+func DeleteApplicationNote(c *gin.Context) {
+	ip := c.ClientIP()
+	idStr := c.Param("application_id")
+	noteIDStr := c.Param("note_id")
+	logger.Info.Printf("[controllers.DeleteApplicationNote] Client IP: %s - Request to delete note %s of application %s\n", ip, noteIDStr, idStr)
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		handleError(c, errs.ErrIDIsNotCorrect)
+		return
+	}
+	noteID, err := strconv.ParseUint(noteIDStr, 10, 32)
+	if err != nil {
+		handleError(c, errs.ErrIDIsNotCorrect)
+		return
+	}
+	userID, err := service.GetUserIDFromToken(c)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	if err := service.DeleteApplicationNote(userID, uint(id), uint(noteID)); err != nil {
+		handleError(c, err)
+		return
+	}
+	logger.Info.Printf("[controllers.DeleteApplicationNote] Client IP: %s - Successfully deleted note %v of application %v\n", ip, noteID, id)
+	c.JSON(http.StatusOK, NewDefaultResponse("Note deleted successfully"))
+}
+
+// RateApplication godoc
+// @Summary Rate an application
+// @Description Set a private rating from 1 to 5 on an application. The applicant never sees the rating.
+// @Tags Applications
+// @Accept json
+// @Produce json
+// @Param application_id path integer true "Application ID"
+// @Param rating body models.SwagApplicationRating true "Rating"
+// @Success 200 {object} DefaultResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /applications/{application_id}/rating [patch]
+// @Security ApiKeyAuth
+func RateApplication(c *gin.Context) {
+	ip := c.ClientIP()
+	idStr := c.Param("application_id")
+	logger.Info.Printf("[controllers.RateApplication] Client IP: %s - Request to rate application %s\n", ip, idStr)
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		handleError(c, errs.ErrIDIsNotCorrect)
+		return
+	}
+	var rating models.SwagApplicationRating
+	if err := c.ShouldBindJSON(&rating); err != nil {
+		logger.Error.Printf("[controllers.RateApplication] Client IP: %s - Error parsing rating: %v\n", ip, err)
+		handleError(c, errs.ErrShouldBindJson)
+		return
+	}
+	userID, err := service.GetUserIDFromToken(c)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	if err := service.RateApplication(userID, uint(id), rating.Rating); err != nil {
+		handleError(c, err)
+		return
+	}
+	logger.Info.Printf("[controllers.RateApplication] Client IP: %s - Successfully rated application %v\n", ip, id)
+	c.JSON(http.StatusOK, NewDefaultResponse("Application rated successfully"))
+}
@@ -9,8 +9,24 @@ import (
 	"github.com/gin-gonic/gin"
 	"net/http"
 	"strconv"
+	"time"
 )
 
+// GetAllApplications godoc
+// @Summary Get all applications
+// @Description Get a page of all applications in the system. Available to admins only.
+// @Tags Applications
+// @Accept json
+// @Produce json
+// @Param sort query string false "Comma separated sort fields: created_at, status_id, prefix with - for descending"
+// @Param page query integer false "Page number, starting from 1"
+// @Param size query integer false "Page size, up to 100"
+// @Param cursor query string false "Cursor of the next page returned by the previous request"
+// @Success 200 {object} PageResponse[models.Application]
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Router /applications [get]
+// @Security ApiKeyAuth
 func GetAllApplications(c *gin.Context) {
 	ip := c.ClientIP()
 	logger.Info.Printf("[controllers.GetAllApplications] Client IP: %s - Client requested all applications\n", ip)
@@ -19,13 +35,148 @@ func GetAllApplications(c *gin.Context) {
 		handleError(c, err)
 		return
 	}
-	applications, err := service.GetAllApplications(userID)
+	params, err := parsePageParams(c)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+	applications, info, err := service.GetAllApplications(userID, params)
 	if err != nil {
 		handleError(c, err)
 		return
 	}
 	logger.Info.Printf("[controllers.GetAllApplications] Client IP: %s - Successfully retrieved all applications\n", ip)
-	c.JSON(http.StatusOK, applications)
+	c.JSON(http.StatusOK, NewPageResponse(applications, params, info))
+}
+
+// parseApplicationFilter reads the status, date-from, date-to and min-experience-years
+// query parameters of the employer application lists. Dates are inclusive, in YYYY-MM-DD format.
+func parseApplicationFilter(c *gin.Context) (filter models.ApplicationFilter, err error) {
+	filter.Status = c.Query("status")
+	if fromStr := c.Query("date-from"); fromStr != "" {
+		from, err := time.Parse(time.DateOnly, fromStr)
+		if err != nil {
+			return filter, errs.ErrIncorrectInput
+		}
+		filter.CreatedFrom = &from
+	}
+	if toStr := c.Query("date-to"); toStr != "" {
+		to, err := time.Parse(time.DateOnly, toStr)
+		if err != nil {
+			return filter, errs.ErrIncorrectInput
+		}
+		to = to.AddDate(0, 0, 1)
+		filter.CreatedTo = &to
+	}
+	if experienceStr := c.Query("min-experience-years"); experienceStr != "" {
+		filter.MinExperienceYears, err = strconv.Atoi(experienceStr)
+		if err != nil || filter.MinExperienceYears < 0 {
+			return filter, errs.ErrIncorrectInput
+		}
+	}
+	return filter, nil
+}
+
+// GetVacancyApplications godoc
+// @Summary Get applications for a vacancy
+// @Description Get the applications received for a vacancy with the candidates' resumes. Available to the vacancy author and members of its company.
+// @Tags Applications
+// @Accept json
+// @Produce json
+// @Param vacancyID path integer true "Vacancy ID"
+// @Param status query string false "Application status, e.g. applied, under_review, interview"
+// @Param date-from query string false "Applied on or after date (YYYY-MM-DD)"
+// @Param date-to query string false "Applied on or before date (YYYY-MM-DD)"
+// @Param min-experience-years query integer false "Minimum years of experience in the resume"
+// @Param sort query string false "Comma separated sort fields: created_at, status_id, prefix with - for descending"
+// @Param page query integer false "Page number, starting from 1"
+// @Param size query integer false "Page size, up to 100"
+// @Param cursor query string false "Cursor of the next page returned by the previous request"
+// @Success 200 {object} PageResponse[models.Application]
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /vacancies/{vacancyID}/applications [get]
+// @Security ApiKeyAuth
+func GetVacancyApplications(c *gin.Context) {
+	ip := c.ClientIP()
+	idStr := c.Param("vacancyID")
+	logger.Info.Printf("[controllers.GetVacancyApplications] Client IP: %s - Request to get applications of vacancy %s\n", ip, idStr)
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		handleError(c, errs.ErrIDIsNotCorrect)
+		return
+	}
+	userID, err := service.GetUserIDFromToken(c)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+	filter, err := parseApplicationFilter(c)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+	params, err := parsePageParams(c)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	applications, info, err := service.GetVacancyApplications(userID, uint(id), filter, params)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+	logger.Info.Printf("[controllers.GetVacancyApplications] Client IP: %s - Successfully retrieved applications of vacancy %v\n", ip, id)
+	c.JSON(http.StatusOK, NewPageResponse(applications, params, info))
+}
+
+// GetReceivedApplications godoc
+// @Summary Get received applications
+// @Description Get the applications received for all vacancies of the current employer and of the companies they are a member of, with the candidates' resumes.
+// @Tags Applications
+// @Accept json
+// @Produce json
+// @Param status query string false "Application status, e.g. applied, under_review, interview"
+// @Param date-from query string false "Applied on or after date (YYYY-MM-DD)"
+// @Param date-to query string false "Applied on or before date (YYYY-MM-DD)"
+// @Param min-experience-years query integer false "Minimum years of experience in the resume"
+// @Param sort query string false "Comma separated sort fields: created_at, status_id, prefix with - for descending"
+// @Param page query integer false "Page number, starting from 1"
+// @Param size query integer false "Page size, up to 100"
+// @Param cursor query string false "Cursor of the next page returned by the previous request"
+// @Success 200 {object} PageResponse[models.Application]
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Router /applications/received [get]
+// @Security ApiKeyAuth
+func GetReceivedApplications(c *gin.Context) {
+	ip := c.ClientIP()
+	logger.Info.Printf("[controllers.GetReceivedApplications] Client IP: %s - Request to get received applications\n", ip)
+	userID, err := service.GetUserIDFromToken(c)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+	filter, err := parseApplicationFilter(c)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+	params, err := parsePageParams(c)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	applications, info, err := service.GetReceivedApplications(userID, filter, params)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+	logger.Info.Printf("[controllers.GetReceivedApplications] Client IP: %s - Successfully retrieved received applications\n", ip)
+	c.JSON(http.StatusOK, NewPageResponse(applications, params, info))
 }
 
 // GetApplicationByID godoc
@@ -43,7 +194,7 @@ func GetAllApplications(c *gin.Context) {
 // @Security ApiKeyAuth
 func GetApplicationByID(c *gin.Context) {
 	ip := c.ClientIP()
-	idStr := c.Param("id")
+	idStr := c.Param("application_id")
 	id, err := strconv.ParseUint(idStr, 10, 32)
 	if err != nil {
 		logger.Info.Printf("[controllers.GetApplicationByID] Client IP: %s - Client requested application with ID %s. Error: Invalid application ID\n", ip, idStr)
@@ -67,7 +218,7 @@ func GetApplicationByID(c *gin.Context) {
 
 // AddApplication godoc
 // @Summary Add a new application
-// @Description Add a new application. Requires authentication.
+// @Description Apply to a vacancy with one of your resumes. An application starts in the "applied" status, and a user can apply to a vacancy only once.
 // @Tags Applications
 // @Accept json
 // @Produce json
@@ -82,16 +233,20 @@ func AddApplication(c *gin.Context) {
 	var application models.SwaggerApplication
 	if err := c.ShouldBindJSON(&application); err != nil {
 		logger.Info.Printf("[controllers.AddApplication] Client IP: %s - Client attempted to add application with data %v. Error: Invalid input\n", ip, application)
+		handleError(c, errs.ErrShouldBindJson)
+		return
+	}
+	userID, err := service.GetUserIDFromToken(c)
+	if err != nil {
 		handleError(c, err)
 		return
 	}
 	app := models.Application{
-		UserID:    application.UserID,
-		VacancyID: application.VacancyID,
-		ResumeID:  application.ResumeID,
-		StatusID:  application.StatusID,
+		VacancyID:   application.VacancyID,
+		ResumeID:    application.ResumeID,
+		CoverLetter: application.CoverLetter,
 	}
-	if err := service.AddApplication(app); err != nil {
+	if err := service.AddApplication(userID, app); err != nil {
 		handleError(c, err)
 		return
 	}
@@ -102,26 +257,29 @@ func AddApplication(c *gin.Context) {
 func UpdateApplication(c *gin.Context) {
 	ip := c.ClientIP()
 	var application models.SwaggerApplication
-	idStr := c.Param("id")
+	idStr := c.Param("application_id")
 	id, err := strconv.ParseUint(idStr, 10, 32)
 	if err != nil {
 		logger.Info.Printf("[controllers.UpdateApplication] Client IP: %s - Client attempted to update application with ID %s. Error: Invalid application ID\n", ip, idStr)
-		handleError(c, err)
+		handleError(c, errs.ErrIDIsNotCorrect)
 		return
 	}
-	app := models.Application{
-		ID:        uint(id),
-		UserID:    application.UserID,
-		VacancyID: application.VacancyID,
-		ResumeID:  application.ResumeID,
-		StatusID:  application.StatusID,
-	}
 	if err := c.ShouldBindJSON(&application); err != nil {
 		logger.Info.Printf("[controllers.UpdateApplication] Client IP: %s - Client attempted to update application with ID %v using data %v. Error: Invalid input\n", ip, id, application)
 		handleError(c, err)
 		return
 	}
-	if err := service.UpdateApplication(app); err != nil {
+	userID, err := service.GetUserIDFromToken(c)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+	app := models.Application{
+		ID:          uint(id),
+		ResumeID:    application.ResumeID,
+		CoverLetter: application.CoverLetter,
+	}
+	if err := service.UpdateApplication(userID, app); err != nil {
 		logger.Info.Printf("[controllers.UpdateApplication] Client IP: %s - Error updating application with ID %v\n", ip, id)
 		handleError(c, err)
 		return
@@ -132,7 +290,7 @@ func UpdateApplication(c *gin.Context) {
 
 // DeleteApplication godoc
 // @Summary Delete an application
-// @Description Soft delete an application by its ID. Requires authentication.
+// @Description Delete an application by its ID. An application deleted by the applicant is withdrawn and stays visible to the employer, the applicant can't apply to the vacancy again.
 // @Tags Applications
 // @Accept json
 // @Produce json
@@ -145,7 +303,7 @@ func UpdateApplication(c *gin.Context) {
 // @Security ApiKeyAuth
 func DeleteApplication(c *gin.Context) {
 	ip := c.ClientIP()
-	idStr := c.Param("id")
+	idStr := c.Param("application_id")
 	id, err := strconv.ParseUint(idStr, 10, 32)
 	if err != nil {
 		logger.Info.Printf("[controllers.DeleteApplication] Client IP: %s - Client attempted to delete application with ID %s. Error: Invalid application ID\n", ip, idStr)
@@ -158,7 +316,7 @@ func DeleteApplication(c *gin.Context) {
 		return
 	}
 
-	if err := service.DeleteApplication(userID, uint(id)); err != nil {
+	if err := service.DeleteApplication(uint(id), userID); err != nil {
 		logger.Info.Printf("[controllers.DeleteApplication] Client IP: %s - Error deleting application with ID %v\n", ip, id)
 		handleError(c, err)
 		return
@@ -169,7 +327,7 @@ func DeleteApplication(c *gin.Context) {
 
 // UpdateApplicationStatus godoc
 // @Summary Update the status of an application
-// @Description Update the status of a specific application by its ID
+// @Description Move an application to another status. Allowed transitions: applied -> under_review -> interview -> offer -> hired, any non-terminal status -> rejected. Only the vacancy author and members of its company can change the status.
 // @Tags Applications
 // @Accept json
 // @Produce json
@@ -215,7 +373,7 @@ func UpdateApplicationStatus(c *gin.Context) {
 	userID, err := service.GetUserIDFromToken(c)
 	if err != nil {
 		logger.Info.Printf("[controllers.UpdateApplicationStatus] Client IP: %s - Failed to extract user ID from token. Error: %v\n", ip, err)
-		handleError(c, fmt.Errorf("unable to extract user ID from token"))
+		handleError(c, err)
 		return
 	}
 
@@ -224,10 +382,122 @@ func UpdateApplicationStatus(c *gin.Context) {
 	err = service.UpdateApplicationStatus(uint(applicationID), uint(statusID), userID)
 	if err != nil {
 		logger.Info.Printf("[controllers.UpdateApplicationStatus] Client IP: %s - Failed to update status for application ID: %d. Error: %v\n", ip, applicationID, err)
-		handleError(c, fmt.Errorf("failed to update application status"))
+		handleError(c, err)
 		return
 	}
 
 	logger.Info.Printf("[controllers.UpdateApplicationStatus] Client IP: %s - Successfully updated application ID: %d to status ID: %d\n", ip, applicationID, statusID)
 	c.JSON(http.StatusOK, DefaultResponse{Message: "Application status updated successfully"})
 }
+
+// GetApplicationStatusHistory godoc
+// @Summary Get application status history
+// @Description Get the timeline of status changes of an application: who changed the status and when. Available to the applicant, the vacancy author and members of its company.
+// @Tags Applications
+// @Accept json
+// @Produce json
+// @Param application_id path integer true "Application ID"
+// @Success 200 {array} models.ApplicationStatusHistory
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /applications/{application_id}/history [get]
+// @Security ApiKeyAuth
+func GetApplicationStatusHistory(c *gin.Context) {
+	ip := c.ClientIP()
+	idStr := c.Param("application_id")
+	logger.Info.Printf("[controllers.GetApplicationStatusHistory] Client IP: %s - Request to get status history of application %s\n", ip, idStr)
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		handleError(c, errs.ErrIDIsNotCorrect)
+		return
+	}
+	userID, err := service.GetUserIDFromToken(c)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	history, err := service.GetApplicationStatusHistory(userID, uint(id))
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+	logger.Info.Printf("[controllers.GetApplicationStatusHistory] Client IP: %s - Successfully retrieved status history of application %v\n", ip, id)
+	c.JSON(http.StatusOK, history)
+}
+
+// GetMyApplications godoc
+// @Summary Get my applications
+// @Description Get the applications sent by the current user with the vacancy, its company and the current status.
+// @Tags Applications
+// @Accept json
+// @Produce json
+// @Param status query string false "Application status, e.g. applied, interview, withdrawn"
+// @Param sort query string false "Comma separated sort fields: created_at, status_id, prefix with - for descending"
+// @Param page query integer false "Page number, starting from 1"
+// @Param size query integer false "Page size, up to 100"
+// @Param cursor query string false "Cursor of the next page returned by the previous request"
+// @Success 200 {object} PageResponse[models.Application]
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Router /me/applications [get]
+// @Security ApiKeyAuth
+func GetMyApplications(c *gin.Context) {
+	ip := c.ClientIP()
+	logger.Info.Printf("[controllers.GetMyApplications] Client IP: %s - Request to get own applications\n", ip)
+	userID, err := service.GetUserIDFromToken(c)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+	params, err := parsePageParams(c)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	applications, info, err := service.GetMyApplications(userID, c.Query("status"), params)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+	logger.Info.Printf("[controllers.GetMyApplications] Client IP: %s - Successfully retrieved applications of user %v\n", ip, userID)
+	c.JSON(http.StatusOK, NewPageResponse(applications, params, info))
+}
+
+// WithdrawApplication godoc
+// @Summary Withdraw an application
+// @Description Withdraw an application sent by the current user. Withdrawn applications can't be moved to any other status.
+// @Tags Applications
+// @Accept json
+// @Produce json
+// @Param application_id path integer true "Application ID"
+// @Success 200 {object} DefaultResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /applications/{application_id}/withdraw [patch]
+// @Security ApiKeyAuth
+func WithdrawApplication(c *gin.Context) {
+	ip := c.ClientIP()
+	idStr := c.Param("application_id")
+	logger.Info.Printf("[controllers.WithdrawApplication] Client IP: %s - Request to withdraw application %s\n", ip, idStr)
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		handleError(c, errs.ErrIDIsNotCorrect)
+		return
+	}
+	userID, err := service.GetUserIDFromToken(c)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	if err := service.WithdrawApplication(userID, uint(id)); err != nil {
+		handleError(c, err)
+		return
+	}
+	logger.Info.Printf("[controllers.WithdrawApplication] Client IP: %s - Successfully withdrew application %v\n", ip, id)
+	c.JSON(http.StatusOK, NewDefaultResponse("Application withdrawn successfully"))
+}
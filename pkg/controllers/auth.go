@@ -5,6 +5,7 @@ import (
 	"TajikCareerHub/logger"
 	"TajikCareerHub/models"
 	"TajikCareerHub/pkg/service"
+	"TajikCareerHub/utils/errs"
 	"github.com/gin-gonic/gin"
 	"net/http"
 )
@@ -68,11 +69,74 @@ func SignIn(c *gin.Context) {
 		handleError(c, err)
 		return
 	}
-	accessToken, err := service.SignIn(user.UserName, user.Password)
+	accessToken, refreshToken, err := service.SignIn(user.UserName, user.Password)
 	if err != nil {
 		handleError(c, err)
 		return
 	}
 	logger.Info.Printf("Client with IP: %s successfully signed in", ip)
-	c.JSON(http.StatusOK, AccessTokenResponse{accessToken})
+	c.JSON(http.StatusOK, AccessTokenResponse{accessToken, refreshToken})
+}
+
+// RefreshTokens
+// @Summary Refresh access token
+// @Tags Authorization
+// @Description Exchange a refresh token for a new access token and a rotated refresh token
+// @ID refresh-tokens
+// @Accept json
+// @Produce json
+// @Param input body RefreshTokenRequest true "Refresh token"
+// @Success 200 {object} AccessTokenResponse
+// @Failure 400 {object} ErrorResponse "Bad Request"
+// @Failure 403 {object} ErrorResponse "Invalid, expired or reused refresh token"
+// @Failure 500 {object} ErrorResponse "Internal Server Error"
+// @Router /auth/refresh [post]
+func RefreshTokens(c *gin.Context) {
+	ip := c.ClientIP()
+	logger.Info.Printf("Client with IP: %s requested to refresh tokens", ip)
+
+	var request RefreshTokenRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		logger.Error.Printf("Client with IP: %s failed to refresh tokens: Error parsing request body: %v", ip, err)
+		handleError(c, errs.ErrShouldBindJson)
+		return
+	}
+	accessToken, refreshToken, err := service.RefreshTokens(request.RefreshToken)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+	logger.Info.Printf("Client with IP: %s successfully refreshed tokens", ip)
+	c.JSON(http.StatusOK, AccessTokenResponse{accessToken, refreshToken})
+}
+
+// SignOut
+// @Summary Sign out
+// @Tags Authorization
+// @Description Revoke the refresh token and every token rotated from the same sign-in
+// @ID sign-out
+// @Accept json
+// @Produce json
+// @Param input body RefreshTokenRequest true "Refresh token"
+// @Success 200 {object} DefaultResponse "Signed out successfully"
+// @Failure 400 {object} ErrorResponse "Bad Request"
+// @Failure 403 {object} ErrorResponse "Invalid refresh token"
+// @Failure 500 {object} ErrorResponse "Internal Server Error"
+// @Router /auth/sign-out [post]
+func SignOut(c *gin.Context) {
+	ip := c.ClientIP()
+	logger.Info.Printf("Client with IP: %s requested to sign out", ip)
+
+	var request RefreshTokenRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		logger.Error.Printf("Client with IP: %s failed to sign out: Error parsing request body: %v", ip, err)
+		handleError(c, errs.ErrShouldBindJson)
+		return
+	}
+	if err := service.SignOut(request.RefreshToken); err != nil {
+		handleError(c, err)
+		return
+	}
+	logger.Info.Printf("Client with IP: %s successfully signed out", ip)
+	c.JSON(http.StatusOK, NewDefaultResponse("Signed out successfully"))
 }
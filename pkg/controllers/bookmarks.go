@@ -0,0 +1,247 @@
+package controllers
+
+import (
+	"TajikCareerHub/logger"
+	"TajikCareerHub/models"
+	"TajikCareerHub/pkg/service"
+	"TajikCareerHub/utils/errs"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"strconv"
+)
+
+// GetMyVacancyFavorites godoc
+// @Summary Get my favorite vacancies
+// @Description Get the vacancies the current user added to favorites. Vacancies that are no longer listed, for example because they were blocked, closed or expired, stay in the list with available set to false and without their details.
+// @Tags Bookmarks
+// @Accept json
+// @Produce json
+// @Param tag query string false "Only favorites with this tag"
+// @Param sort query string false "Comma separated sort fields: created_at, updated_at, title, prefix with - for descending"
+// @Param page query integer false "Page number, starting from 1"
+// @Param size query integer false "Page size, up to 100"
+// @Param cursor query string false "Cursor of the next page returned by the previous request"
+// @Success 200 {object} PageResponse[models.VacancyFavorite]
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Router /me/favorites [get]
+// @Security ApiKeyAuth
+func GetMyVacancyFavorites(c *gin.Context) {
+	ip := c.ClientIP()
+	logger.Info.Printf("[controllers.GetMyVacancyFavorites] Client IP: %s - Request to get favorite vacancies\n", ip)
+	userID, err := service.GetUserIDFromToken(c)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+	params, err := parsePageParams(c)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	favorites, info, err := service.GetMyVacancyFavorites(userID, c.Query("tag"), params)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+	logger.Info.Printf("[controllers.GetMyVacancyFavorites] Client IP: %s - Successfully retrieved favorite vacancies of user %v\n", ip, userID)
+	c.JSON(http.StatusOK, NewPageResponse(favorites, params, info))
+}
+
+// SaveVacancyFavorite godoc
+// @Summary Add a vacancy to favorites
+// @Description Add an open vacancy to the current user's favorites with optional tags and note. Sending it again for a favorite vacancy replaces the tags and note.
+// @Tags Bookmarks
+// @Accept json
+// @Produce json
+// @Param vacancyID path integer true "Vacancy ID"
+// @Param bookmark body models.SwagBookmark false "Tags and note"
+// @Success 200 {object} DefaultResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /me/favorites/{vacancyID} [put]
+// @Security ApiKeyAuth
+func SaveVacancyFavorite(c *gin.Context) {
+	ip := c.ClientIP()
+	idStr := c.Param("vacancyID")
+	logger.Info.Printf("[controllers.SaveVacancyFavorite] Client IP: %s - Request to add vacancy %s to favorites\n", ip, idStr)
+	vacancyID, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		handleError(c, errs.ErrIDIsNotCorrect)
+		return
+	}
+	userID, err := service.GetUserIDFromToken(c)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+	var input models.SwagBookmark
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&input); err != nil {
+			handleError(c, errs.ErrShouldBindJson)
+			return
+		}
+	}
+
+	if err := service.SaveVacancyFavorite(userID, uint(vacancyID), input); err != nil {
+		handleError(c, err)
+		return
+	}
+	logger.Info.Printf("[controllers.SaveVacancyFavorite] Client IP: %s - Successfully added vacancy %v to favorites of user %v\n", ip, vacancyID, userID)
+	c.JSON(http.StatusOK, NewDefaultResponse("Vacancy added to favorites"))
+}
+
+// RemoveVacancyFavorite godoc
+// @Summary Remove a vacancy from favorites
+// @Tags Bookmarks
+// @Accept json
+// @Produce json
+// @Param vacancyID path integer true "Vacancy ID"
+// @Success 200 {object} DefaultResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /me/favorites/{vacancyID} [delete]
+// @Security ApiKeyAuth
+func RemoveVacancyFavorite(c *gin.Context) {
+	ip := c.ClientIP()
+	idStr := c.Param("vacancyID")
+	logger.Info.Printf("[controllers.RemoveVacancyFavorite] Client IP: %s - Request to remove vacancy %s from favorites\n", ip, idStr)
+	vacancyID, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		handleError(c, errs.ErrIDIsNotCorrect)
+		return
+	}
+	userID, err := service.GetUserIDFromToken(c)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	if err := service.RemoveVacancyFavorite(userID, uint(vacancyID)); err != nil {
+		handleError(c, err)
+		return
+	}
+	logger.Info.Printf("[controllers.RemoveVacancyFavorite] Client IP: %s - Successfully removed vacancy %v from favorites of user %v\n", ip, vacancyID, userID)
+	c.JSON(http.StatusOK, NewDefaultResponse("Vacancy removed from favorites"))
+}
+
+// GetMyResumeShortlist godoc
+// @Summary Get my resume shortlist
+// @Description Get the resumes the current user shortlisted. Resumes that were blocked, or whose author was blocked, stay in the list with available set to false and without their details.
+// @Tags Bookmarks
+// @Accept json
+// @Produce json
+// @Param tag query string false "Only resumes with this tag"
+// @Param sort query string false "Comma separated sort fields: created_at, updated_at, title, prefix with - for descending"
+// @Param page query integer false "Page number, starting from 1"
+// @Param size query integer false "Page size, up to 100"
+// @Param cursor query string false "Cursor of the next page returned by the previous request"
+// @Success 200 {object} PageResponse[models.ResumeShortlistItem]
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Router /me/shortlist [get]
+// @Security ApiKeyAuth
+func GetMyResumeShortlist(c *gin.Context) {
+	ip := c.ClientIP()
+	logger.Info.Printf("[controllers.GetMyResumeShortlist] Client IP: %s - Request to get resume shortlist\n", ip)
+	userID, err := service.GetUserIDFromToken(c)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+	params, err := parsePageParams(c)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	items, info, err := service.GetMyResumeShortlist(userID, c.Query("tag"), params)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+	logger.Info.Printf("[controllers.GetMyResumeShortlist] Client IP: %s - Successfully retrieved resume shortlist of user %v\n", ip, userID)
+	c.JSON(http.StatusOK, NewPageResponse(items, params, info))
+}
+
+// SaveResumeShortlistItem godoc
+// @Summary Shortlist a resume
+// @Description Add a resume to the current user's shortlist with optional tags and note. Sending it again for a shortlisted resume replaces the tags and note.
+// @Tags Bookmarks
+// @Accept json
+// @Produce json
+// @Param resumeID path integer true "Resume ID"
+// @Param bookmark body models.SwagBookmark false "Tags and note"
+// @Success 200 {object} DefaultResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /me/shortlist/{resumeID} [put]
+// @Security ApiKeyAuth
+func SaveResumeShortlistItem(c *gin.Context) {
+	ip := c.ClientIP()
+	idStr := c.Param("resumeID")
+	logger.Info.Printf("[controllers.SaveResumeShortlistItem] Client IP: %s - Request to shortlist resume %s\n", ip, idStr)
+	resumeID, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		handleError(c, errs.ErrIDIsNotCorrect)
+		return
+	}
+	userID, err := service.GetUserIDFromToken(c)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+	var input models.SwagBookmark
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&input); err != nil {
+			handleError(c, errs.ErrShouldBindJson)
+			return
+		}
+	}
+
+	if err := service.SaveResumeShortlistItem(userID, uint(resumeID), input); err != nil {
+		handleError(c, err)
+		return
+	}
+	logger.Info.Printf("[controllers.SaveResumeShortlistItem] Client IP: %s - Successfully shortlisted resume %v for user %v\n", ip, resumeID, userID)
+	c.JSON(http.StatusOK, NewDefaultResponse("Resume added to shortlist"))
+}
+
+// RemoveResumeShortlistItem godoc
+// @Summary Remove a resume from the shortlist
+// @Tags Bookmarks
+// @Accept json
+// @Produce json
+// @Param resumeID path integer true "Resume ID"
+// @Success 200 {object} DefaultResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /me/shortlist/{resumeID} [delete]
+// @Security ApiKeyAuth
+func RemoveResumeShortlistItem(c *gin.Context) {
+	ip := c.ClientIP()
+	idStr := c.Param("resumeID")
+	logger.Info.Printf("[controllers.RemoveResumeShortlistItem] Client IP: %s - Request to remove resume %s from shortlist\n", ip, idStr)
+	resumeID, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		handleError(c, errs.ErrIDIsNotCorrect)
+		return
+	}
+	userID, err := service.GetUserIDFromToken(c)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	if err := service.RemoveResumeShortlistItem(userID, uint(resumeID)); err != nil {
+		handleError(c, err)
+		return
+	}
+	logger.Info.Printf("[controllers.RemoveResumeShortlistItem] Client IP: %s - Successfully removed resume %v from shortlist of user %v\n", ip, resumeID, userID)
+	c.JSON(http.StatusOK, NewDefaultResponse("Resume removed from shortlist"))
+}
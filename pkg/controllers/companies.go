@@ -4,6 +4,7 @@ import (
 	"TajikCareerHub/logger"
 	"TajikCareerHub/models"
 	"TajikCareerHub/pkg/service"
+	"TajikCareerHub/utils/errs"
 	"github.com/gin-gonic/gin"
 	"net/http"
 	"strconv"
@@ -15,7 +16,12 @@ import (
 // @Tags Companies
 // @Accept json
 // @Produce json
-// @Success 200 {array} models.Company
+// @Param sort query string false "Comma separated sort fields: created_at, name, prefix with - for descending"
+// @Param page query integer false "Page number, starting from 1"
+// @Param size query integer false "Page size, up to 100"
+// @Param cursor query string false "Cursor of the next page returned by the previous request"
+// @Success 200 {object} PageResponse[models.Company]
+// @Failure 400 {object} ErrorResponse
 // @Failure 500 {object} ErrorResponse
 // @Router /companies [get]
 // @Security ApiKeyAuth
@@ -29,13 +35,19 @@ func GetAllCompanies(c *gin.Context) {
 		return
 	}
 
-	companies, err := service.GetAllCompanies(userID)
+	params, err := parsePageParams(c)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	companies, info, err := service.GetAllCompanies(userID, params)
 	if err != nil {
 		handleError(c, err)
 		return
 	}
 	logger.Info.Printf("[controllers.GetAllCompanies] Client IP: %s - Successfully retrieved all companies\n", ip)
-	c.JSON(http.StatusOK, companies)
+	c.JSON(http.StatusOK, NewPageResponse(companies, params, info))
 }
 
 // GetCompanyByID godoc
@@ -102,13 +114,7 @@ func AddCompany(c *gin.Context) {
 		return
 	}
 
-	RoleID, err := service.GetRoleIDFromToken(c)
-	if err != nil {
-		handleError(c, err)
-		return
-	}
-
-	if err := service.AddCompany(userID, company, RoleID); err != nil {
+	if err := service.AddCompany(userID, company); err != nil {
 		handleError(c, err)
 		return
 	}
@@ -152,13 +158,8 @@ func UpdateCompany(c *gin.Context) {
 		handleError(c, err)
 		return
 	}
-	RoleID, err := service.GetRoleIDFromToken(c)
-	if err != nil {
-		handleError(c, err)
-		return
-	}
 
-	if err := service.UpdateCompany(userID, company, RoleID); err != nil {
+	if err := service.UpdateCompany(userID, company); err != nil {
 		handleError(c, err)
 		return
 	}
@@ -196,15 +197,128 @@ func DeleteCompany(c *gin.Context) {
 		return
 	}
 
-	RoleID, err := service.GetRoleIDFromToken(c)
+	if err := service.DeleteCompany(uint(id), userID); err != nil {
+		handleError(c, err)
+		return
+	}
+	logger.Info.Printf("[controllers.DeleteCompany] Client IP: %s - Successfully soft deleted company with ID %v\n", ip, id)
+	c.JSON(http.StatusOK, NewDefaultResponse("Company deleted successfully"))
+}
+
+// VerifyCompany godoc
+// @Summary Review company verification
+// @Description Mark a company as verified or rejected with an optional reviewer note. Vacancies of unverified companies are hidden from listings.
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Param id path integer true "Company ID"
+// @Param verification body models.SwagCompanyVerification true "Verification decision"
+// @Success 200 {object} DefaultResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /companies/verify/{id} [patch]
+// @Security ApiKeyAuth
+func VerifyCompany(c *gin.Context) {
+	ip := c.ClientIP()
+	idStr := c.Param("id")
+	logger.Info.Printf("[controllers.VerifyCompany] Client IP: %s - Request to review company with ID %s\n", ip, idStr)
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		logger.Error.Printf("[controllers.VerifyCompany] Client IP: %s - Invalid company ID %s: %v\n", ip, idStr, err)
+		handleError(c, errs.ErrIDIsNotCorrect)
+		return
+	}
+	var verification models.SwagCompanyVerification
+	if err := c.ShouldBindJSON(&verification); err != nil {
+		logger.Error.Printf("[controllers.VerifyCompany] Client IP: %s - Error parsing verification data: %v\n", ip, err)
+		handleError(c, errs.ErrShouldBindJson)
+		return
+	}
+	userID, err := service.GetUserIDFromToken(c)
 	if err != nil {
 		handleError(c, err)
 		return
 	}
-	if err := service.DeleteCompany(uint(id), userID, RoleID); err != nil {
+
+	if err := service.VerifyCompany(userID, uint(id), verification); err != nil {
 		handleError(c, err)
 		return
 	}
-	logger.Info.Printf("[controllers.DeleteCompany] Client IP: %s - Successfully soft deleted company with ID %v\n", ip, id)
-	c.JSON(http.StatusOK, NewDefaultResponse("Company deleted successfully"))
+	logger.Info.Printf("[controllers.VerifyCompany] Client IP: %s - Company with ID %v marked as %s\n", ip, id, verification.Status)
+	c.JSON(http.StatusOK, NewDefaultResponse("Company reviewed successfully"))
+}
+
+// BlockCompany godoc
+// @Summary Block a company
+// @Description Block a company by its ID. Vacancies of a blocked company are hidden and cannot receive applications.
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Param id path integer true "Company ID"
+// @Success 200 {object} DefaultResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /companies/block/{id} [patch]
+// @Security ApiKeyAuth
+func BlockCompany(c *gin.Context) {
+	ip := c.ClientIP()
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		logger.Error.Printf("[controllers.BlockCompany] Client IP: %s - Invalid company ID %s: %v\n", ip, idStr, err)
+		handleError(c, errs.ErrIDIsNotCorrect)
+		return
+	}
+	logger.Info.Printf("[controllers.BlockCompany] Client IP: %s - Request to block company with ID %d\n", ip, id)
+	userID, err := service.GetUserIDFromToken(c)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	if err := service.BlockCompany(userID, uint(id)); err != nil {
+		handleError(c, err)
+		return
+	}
+	logger.Info.Printf("[controllers.BlockCompany] Client IP: %s - Successfully blocked company with ID %d\n", ip, id)
+	c.JSON(http.StatusOK, NewDefaultResponse("Company blocked successfully"))
+}
+
+// UnblockCompany godoc
+// @Summary Unblock a company
+// @Description Unblock a company by its ID.
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Param id path integer true "Company ID"
+// @Success 200 {object} DefaultResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /companies/unblock/{id} [patch]
+// @Security ApiKeyAuth
+func UnblockCompany(c *gin.Context) {
+	ip := c.ClientIP()
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		logger.Error.Printf("[controllers.UnblockCompany] Client IP: %s - Invalid company ID %s: %v\n", ip, idStr, err)
+		handleError(c, errs.ErrIDIsNotCorrect)
+		return
+	}
+	logger.Info.Printf("[controllers.UnblockCompany] Client IP: %s - Request to unblock company with ID %d\n", ip, id)
+	userID, err := service.GetUserIDFromToken(c)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	if err := service.UnblockCompany(userID, uint(id)); err != nil {
+		handleError(c, err)
+		return
+	}
+	logger.Info.Printf("[controllers.UnblockCompany] Client IP: %s - Successfully unblocked company with ID %d\n", ip, id)
+	c.JSON(http.StatusOK, NewDefaultResponse("Company unblocked successfully"))
 }
@@ -0,0 +1,236 @@
+package controllers
+
+import (
+	"TajikCareerHub/logger"
+	"TajikCareerHub/models"
+	"TajikCareerHub/pkg/service"
+	"TajikCareerHub/utils/errs"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"strconv"
+)
+
+// GetCompanyMembers godoc
+// @Summary Get company members
+// @Description Retrieve the owners and recruiters of a company. Available to company members and admins.
+// @Tags Companies
+// @Accept json
+// @Produce json
+// @Param id path integer true "Company ID"
+// @Success 200 {array} models.CompanyMember
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /companies/{id}/members [get]
+// @Security ApiKeyAuth
+func GetCompanyMembers(c *gin.Context) {
+	ip := c.ClientIP()
+	idStr := c.Param("id")
+	logger.Info.Printf("[controllers.GetCompanyMembers] Client IP: %s - Request to get members of company %s\n", ip, idStr)
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		logger.Error.Printf("[controllers.GetCompanyMembers] Client IP: %s - Invalid company ID %s: %v\n", ip, idStr, err)
+		handleError(c, errs.ErrIDIsNotCorrect)
+		return
+	}
+	userID, err := service.GetUserIDFromToken(c)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	members, err := service.GetCompanyMembers(userID, uint(id))
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+	logger.Info.Printf("[controllers.GetCompanyMembers] Client IP: %s - Successfully retrieved members of company %v\n", ip, id)
+	c.JSON(http.StatusOK, members)
+}
+
+// InviteCompanyMember godoc
+// @Summary Invite a recruiter to a company
+// @Description Invite an employer by username or email to join the company. Only company owners can invite.
+// @Tags Companies
+// @Accept json
+// @Produce json
+// @Param id path integer true "Company ID"
+// @Param invitation body models.SwagCompanyInvitation true "Invitation data"
+// @Success 201 {object} DefaultResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /companies/{id}/invitations [post]
+// @Security ApiKeyAuth
+func InviteCompanyMember(c *gin.Context) {
+	ip := c.ClientIP()
+	idStr := c.Param("id")
+	logger.Info.Printf("[controllers.InviteCompanyMember] Client IP: %s - Request to invite member to company %s\n", ip, idStr)
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		logger.Error.Printf("[controllers.InviteCompanyMember] Client IP: %s - Invalid company ID %s: %v\n", ip, idStr, err)
+		handleError(c, errs.ErrIDIsNotCorrect)
+		return
+	}
+	var invitation models.SwagCompanyInvitation
+	if err := c.ShouldBindJSON(&invitation); err != nil {
+		logger.Error.Printf("[controllers.InviteCompanyMember] Client IP: %s - Error parsing invitation data: %v\n", ip, err)
+		handleError(c, errs.ErrShouldBindJson)
+		return
+	}
+	userID, err := service.GetUserIDFromToken(c)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	if err := service.InviteCompanyMember(userID, uint(id), invitation); err != nil {
+		handleError(c, err)
+		return
+	}
+	logger.Info.Printf("[controllers.InviteCompanyMember] Client IP: %s - Successfully invited member to company %v\n", ip, id)
+	c.JSON(http.StatusCreated, NewDefaultResponse("Invitation sent successfully"))
+}
+
+// RemoveCompanyMember godoc
+// @Summary Remove a company member
+// @Description Remove a recruiter or owner from a company. Owners can remove anyone, members can leave the company themselves.
+// @Tags Companies
+// @Accept json
+// @Produce json
+// @Param id path integer true "Company ID"
+// @Param user_id path integer true "Member user ID"
+// @Success 200 {object} DefaultResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Router /companies/{id}/members/{user_id} [delete]
+// @Security ApiKeyAuth
+func RemoveCompanyMember(c *gin.Context) {
+	ip := c.ClientIP()
+	idStr := c.Param("id")
+	memberIDStr := c.Param("user_id")
+	logger.Info.Printf("[controllers.RemoveCompanyMember] Client IP: %s - Request to remove member %s from company %s\n", ip, memberIDStr, idStr)
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		handleError(c, errs.ErrIDIsNotCorrect)
+		return
+	}
+	memberID, err := strconv.ParseUint(memberIDStr, 10, 32)
+	if err != nil {
+		handleError(c, errs.ErrIDIsNotCorrect)
+		return
+	}
+	userID, err := service.GetUserIDFromToken(c)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	if err := service.RemoveCompanyMember(userID, uint(id), uint(memberID)); err != nil {
+		handleError(c, err)
+		return
+	}
+	logger.Info.Printf("[controllers.RemoveCompanyMember] Client IP: %s - Successfully removed member %v from company %v\n", ip, memberID, id)
+	c.JSON(http.StatusOK, NewDefaultResponse("Company member removed successfully"))
+}
+
+// GetMyCompanyInvitations godoc
+// @Summary Get my company invitations
+// @Description Retrieve pending invitations to join companies for the current user.
+// @Tags Companies
+// @Accept json
+// @Produce json
+// @Success 200 {array} models.CompanyInvitation
+// @Failure 403 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /companies/invitations [get]
+// @Security ApiKeyAuth
+func GetMyCompanyInvitations(c *gin.Context) {
+	ip := c.ClientIP()
+	logger.Info.Printf("[controllers.GetMyCompanyInvitations] Client IP: %s - Request to get company invitations\n", ip)
+	userID, err := service.GetUserIDFromToken(c)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	invitations, err := service.GetMyCompanyInvitations(userID)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+	logger.Info.Printf("[controllers.GetMyCompanyInvitations] Client IP: %s - Successfully retrieved company invitations\n", ip)
+	c.JSON(http.StatusOK, invitations)
+}
+
+// AcceptCompanyInvitation godoc
+// @Summary Accept a company invitation
+// @Description Accept a pending invitation and join the company.
+// @Tags Companies
+// @Accept json
+// @Produce json
+// @Param id path integer true "Invitation ID"
+// @Success 200 {object} DefaultResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /companies/invitations/{id}/accept [patch]
+// @Security ApiKeyAuth
+func AcceptCompanyInvitation(c *gin.Context) {
+	ip := c.ClientIP()
+	idStr := c.Param("id")
+	logger.Info.Printf("[controllers.AcceptCompanyInvitation] Client IP: %s - Request to accept invitation %s\n", ip, idStr)
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		handleError(c, errs.ErrIDIsNotCorrect)
+		return
+	}
+	userID, err := service.GetUserIDFromToken(c)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	if err := service.AcceptCompanyInvitation(userID, uint(id)); err != nil {
+		handleError(c, err)
+		return
+	}
+	logger.Info.Printf("[controllers.AcceptCompanyInvitation] Client IP: %s - Successfully accepted invitation %v\n", ip, id)
+	c.JSON(http.StatusOK, NewDefaultResponse("Invitation accepted successfully"))
+}
+
+// DeclineCompanyInvitation godoc
+// @Summary Decline a company invitation
+// @Description Decline a pending invitation to join a company.
+// @Tags Companies
+// @Accept json
+// @Produce json
+// @Param id path integer true "Invitation ID"
+// @Success 200 {object} DefaultResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /companies/invitations/{id}/decline [patch]
+// @Security ApiKeyAuth
+func DeclineCompanyInvitation(c *gin.Context) {
+	ip := c.ClientIP()
+	idStr := c.Param("id")
+	logger.Info.Printf("[controllers.DeclineCompanyInvitation] Client IP: %s - Request to decline invitation %s\n", ip, idStr)
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		handleError(c, errs.ErrIDIsNotCorrect)
+		return
+	}
+	userID, err := service.GetUserIDFromToken(c)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	if err := service.DeclineCompanyInvitation(userID, uint(id)); err != nil {
+		handleError(c, err)
+		return
+	}
+	logger.Info.Printf("[controllers.DeclineCompanyInvitation] Client IP: %s - Successfully declined invitation %v\n", ip, id)
+	c.JSON(http.StatusOK, NewDefaultResponse("Invitation declined successfully"))
+}
@@ -2,12 +2,85 @@ package controllers
 
 import (
 	"TajikCareerHub/logger"
+	"TajikCareerHub/models"
 	"TajikCareerHub/utils/errs"
 	"errors"
 	"github.com/gin-gonic/gin"
 	"net/http"
+	"strconv"
+	"strings"
 )
 
+// parsePageParams reads the page, size, cursor and sort query parameters shared by all
+// list endpoints. sort is a comma separated list of fields, a leading "-" sorts descending:
+// ?sort=-created_at,title.
+func parsePageParams(c *gin.Context) (params models.PageParams, err error) {
+	params.Page = 1
+	params.Size = models.DefaultPageSize
+	if pageStr := c.Query("page"); pageStr != "" {
+		params.Page, err = strconv.Atoi(pageStr)
+		if err != nil || params.Page < 1 {
+			return params, errs.ErrIncorrectInput
+		}
+	}
+	if sizeStr := c.Query("size"); sizeStr != "" {
+		params.Size, err = strconv.Atoi(sizeStr)
+		if err != nil || params.Size < 1 {
+			return params, errs.ErrIncorrectInput
+		}
+		if params.Size > models.MaxPageSize {
+			params.Size = models.MaxPageSize
+		}
+	}
+	params.Cursor = c.Query("cursor")
+	for _, field := range strings.Split(c.Query("sort"), ",") {
+		field = strings.TrimSpace(field)
+		if field == "" {
+			continue
+		}
+		if strings.HasPrefix(field, "-") {
+			params.Sort = append(params.Sort, models.SortParam{Field: strings.TrimPrefix(field, "-"), Desc: true})
+		} else {
+			params.Sort = append(params.Sort, models.SortParam{Field: field})
+		}
+	}
+	return params, nil
+}
+
+// parseSkillsQuery reads the comma separated skills query parameter: ?skills=go,docker.
+func parseSkillsQuery(c *gin.Context) (skills []string) {
+	for _, name := range strings.Split(c.Query("skills"), ",") {
+		if name = models.NormalizeSkillName(name); name != "" {
+			skills = append(skills, name)
+		}
+	}
+	return skills
+}
+
+// parseLocationIDQuery reads the location-id query parameter, 0 when it is absent.
+func parseLocationIDQuery(c *gin.Context) (uint, error) {
+	idStr := c.Query("location-id")
+	if idStr == "" {
+		return 0, nil
+	}
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		return 0, errs.ErrIDIsNotCorrect
+	}
+	return uint(id), nil
+}
+
+// parseListQuery reads a comma separated query parameter of lower case values:
+// ?remote-mode=remote,hybrid.
+func parseListQuery(c *gin.Context, key string) (values []string) {
+	for _, value := range strings.Split(c.Query(key), ",") {
+		if value = strings.ToLower(strings.TrimSpace(value)); value != "" {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
 func handleError(c *gin.Context, err error) {
 	var statusCode int
 	var errorResponse ErrorResponse
@@ -37,7 +110,57 @@ func handleError(c *gin.Context, err error) {
 		errors.Is(err, errs.ErrShouldBindJson),
 		errors.Is(err, errs.ErrIncorrectInput),
 		errors.Is(err, errs.ErrUniquenessViolation),
-		errors.Is(err, errs.ErrCategoryAlreadyExist):
+		errors.Is(err, errs.ErrCategoryAlreadyExist),
+		errors.Is(err, errs.ErrAlreadyCompanyMember),
+		errors.Is(err, errs.ErrInvalidCompanyMemberRole),
+		errors.Is(err, errs.ErrCannotRemoveLastOwner),
+		errors.Is(err, errs.ErrInvitationNotPending),
+		errors.Is(err, errs.ErrInvitationAlreadyPending),
+		errors.Is(err, errs.ErrApplicationResumeLocked),
+		errors.Is(err, errs.ErrInvalidVerificationStatus),
+		errors.Is(err, errs.ErrInvalidSortField),
+		errors.Is(err, errs.ErrInvalidCursor),
+		errors.Is(err, errs.ErrInvalidApplicationStatus),
+		errors.Is(err, errs.ErrInvalidStatusTransition),
+		errors.Is(err, errs.ErrAlreadyApplied),
+		errors.Is(err, errs.ErrCoverLetterTooLong),
+		errors.Is(err, errs.ErrNoteTextIsRequired),
+		errors.Is(err, errs.ErrNoteTooLong),
+		errors.Is(err, errs.ErrInvalidRating),
+		errors.Is(err, errs.ErrInvalidTimezone),
+		errors.Is(err, errs.ErrInvalidInterviewTime),
+		errors.Is(err, errs.ErrInterviewPlaceIsRequired),
+		errors.Is(err, errs.ErrInvalidMeetingLink),
+		errors.Is(err, errs.ErrInvalidInterviewer),
+		errors.Is(err, errs.ErrInvalidInterviewOutcome),
+		errors.Is(err, errs.ErrInterviewConflict),
+		errors.Is(err, errs.ErrInterviewNotPending),
+		errors.Is(err, errs.ErrInvalidWorkExperience),
+		errors.Is(err, errs.ErrInvalidEducation),
+		errors.Is(err, errs.ErrInvalidSkill),
+		errors.Is(err, errs.ErrInvalidSkillProficiency),
+		errors.Is(err, errs.ErrSkillAlreadyExist),
+		errors.Is(err, errs.ErrInvalidSalaryRange),
+		errors.Is(err, errs.ErrInvalidCurrency),
+		errors.Is(err, errs.ErrInvalidSalaryPeriod),
+		errors.Is(err, errs.ErrInvalidSalaryBasis),
+		errors.Is(err, errs.ErrInvalidVacancyStatus),
+		errors.Is(err, errs.ErrInvalidVacancyExpiry),
+		errors.Is(err, errs.ErrVacancyNotOpen),
+		errors.Is(err, errs.ErrInvalidEmploymentType),
+		errors.Is(err, errs.ErrInvalidWorkSchedule),
+		errors.Is(err, errs.ErrInvalidRemoteMode),
+		errors.Is(err, errs.ErrInvalidExperienceRange),
+		errors.Is(err, errs.ErrInvalidLanguage),
+		errors.Is(err, errs.ErrInvalidLanguageLevel),
+		errors.Is(err, errs.ErrInvalidLocationType),
+		errors.Is(err, errs.ErrSavedSearchNameIsRequired),
+		errors.Is(err, errs.ErrSavedSearchNameTooLong),
+		errors.Is(err, errs.ErrInvalidSavedSearchFrequency),
+		errors.Is(err, errs.ErrTooManySavedSearches),
+		errors.Is(err, errs.ErrTooManyBookmarkTags),
+		errors.Is(err, errs.ErrInvalidBookmarkTag),
+		errors.Is(err, errs.ErrBookmarkNoteTooLong):
 		statusCode = http.StatusBadRequest
 		errorResponse = NewErrorResponse(err.Error())
 
@@ -47,18 +170,30 @@ func handleError(c *gin.Context, err error) {
 		errors.Is(err, errs.ErrResumeNotFound),
 		errors.Is(err, errs.ErrUserNotFound),
 		errors.Is(err, errs.ErrVacancyNotFound),
-		errors.Is(err, errs.ErrCompanyNotFound):
+		errors.Is(err, errs.ErrCompanyNotFound),
+		errors.Is(err, errs.ErrInterviewNotFound),
+		errors.Is(err, errs.ErrSkillNotFound),
+		errors.Is(err, errs.ErrNotificationNotFound),
+		errors.Is(err, errs.ErrLocationNotFound),
+		errors.Is(err, errs.ErrSavedSearchNotFound),
+		errors.Is(err, errs.ErrBookmarkNotFound):
 		statusCode = http.StatusNotFound
 		errorResponse = NewErrorResponse(err.Error())
 
 	case errors.Is(err, errs.ErrPermissionDenied),
 		errors.Is(err, errs.ErrAccessDenied),
+		errors.Is(err, errs.ErrNotCompanyMember),
 		errors.Is(err, errs.ErrUserBlocked),
 		errors.Is(err, errs.ErrResumeBlocked),
 		errors.Is(err, errs.ErrVacancyBlocked),
+		errors.Is(err, errs.ErrCompanyBlocked),
+		errors.Is(err, errs.ErrCompanyNotVerified),
 		errors.Is(err, errs.ErrRoleCannotBeAdmin),
 		errors.Is(err, errs.ErrRoleExist),
 		errors.Is(err, errs.ErrInvalidToken),
+		errors.Is(err, errs.ErrRefreshTokenExpired),
+		errors.Is(err, errs.ErrRefreshTokenReused),
+		errors.Is(err, errs.ErrTokenRevoked),
 		errors.Is(err, errs.ErrUnexpectedSigningMethod),
 		errors.Is(err, errs.ErrAuthorizationHeaderMissing):
 		statusCode = http.StatusForbidden
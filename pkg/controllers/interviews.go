@@ -0,0 +1,254 @@
+package controllers
+
+import (
+	"TajikCareerHub/logger"
+	"TajikCareerHub/models"
+	"TajikCareerHub/pkg/service"
+	"TajikCareerHub/utils/errs"
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const defaultInterviewDurationMinutes = 60
+
+func interviewFromRequest(request models.SwagInterview) (models.Interview, error) {
+	interview := models.Interview{
+		StartsAt:      request.StartsAt,
+		Timezone:      request.Timezone,
+		Location:      request.Location,
+		MeetingLink:   request.MeetingLink,
+		InterviewerID: request.InterviewerID,
+	}
+	if request.DurationMinutes < 0 {
+		return interview, errs.ErrInvalidInterviewTime
+	}
+	duration := request.DurationMinutes
+	if duration == 0 {
+		duration = defaultInterviewDurationMinutes
+	}
+	if !request.StartsAt.IsZero() {
+		interview.EndsAt = request.StartsAt.Add(time.Duration(duration) * time.Minute)
+	}
+	return interview, nil
+}
+
+// ScheduleInterview godoc
+// @Summary Schedule an interview
+// @Description Schedule an interview for an application and move it to the "interview" status. The interviewer defaults to the current user and must be the vacancy author or a member of its company. Interviews overlapping another pending interview of the vacancy's company or of the interviewer are rejected.
+// @Tags Interviews
+// @Accept json
+// @Produce json
+// @Param application_id path integer true "Application ID"
+// @Param interview body models.SwagInterview true "Interview data"
+// @Success 201 {object} models.Interview
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /applications/{application_id}/interviews [post]
+// @Security ApiKeyAuth
+func ScheduleInterview(c *gin.Context) {
+	ip := c.ClientIP()
+	idStr := c.Param("application_id")
+	logger.Info.Printf("[controllers.ScheduleInterview] Client IP: %s - Request to schedule interview for application %s\n", ip, idStr)
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		handleError(c, errs.ErrIDIsNotCorrect)
+		return
+	}
+	var request models.SwagInterview
+	if err := c.ShouldBindJSON(&request); err != nil {
+		logger.Error.Printf("[controllers.ScheduleInterview] Client IP: %s - Error parsing interview data: %v\n", ip, err)
+		handleError(c, errs.ErrShouldBindJson)
+		return
+	}
+	if request.StartsAt.IsZero() {
+		handleError(c, errs.ErrInvalidInterviewTime)
+		return
+	}
+	interview, err := interviewFromRequest(request)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+	userID, err := service.GetUserIDFromToken(c)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	interview, err = service.ScheduleInterview(userID, uint(id), interview)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+	logger.Info.Printf("[controllers.ScheduleInterview] Client IP: %s - Successfully scheduled interview %v for application %v\n", ip, interview.ID, id)
+	c.JSON(http.StatusCreated, interview)
+}
+
+// GetApplicationInterviews godoc
+// @Summary Get interviews of an application
+// @Description Get the interviews scheduled for an application. Available to the applicant, the vacancy author and members of its company.
+// @Tags Interviews
+// @Accept json
+// @Produce json
+// @Param application_id path integer true "Application ID"
+// @Success 200 {array} models.Interview
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /applications/{application_id}/interviews [get]
+// @Security ApiKeyAuth
+func GetApplicationInterviews(c *gin.Context) {
+	ip := c.ClientIP()
+	idStr := c.Param("application_id")
+	logger.Info.Printf("[controllers.GetApplicationInterviews] Client IP: %s - Request to get interviews of application %s\n", ip, idStr)
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		handleError(c, errs.ErrIDIsNotCorrect)
+		return
+	}
+	userID, err := service.GetUserIDFromToken(c)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	interviews, err := service.GetApplicationInterviews(userID, uint(id))
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+	logger.Info.Printf("[controllers.GetApplicationInterviews] Client IP: %s - Successfully retrieved interviews of application %v\n", ip, id)
+	c.JSON(http.StatusOK, interviews)
+}
+
+// RescheduleInterview godoc
+// @Summary Reschedule an interview
+// @Description Change the time, place or interviewer of a pending interview. Omitted fields are kept. The new time may not overlap another pending interview of the vacancy's company or of the interviewer.
+// @Tags Interviews
+// @Accept json
+// @Produce json
+// @Param id path integer true "Interview ID"
+// @Param interview body models.SwagInterview true "Interview data"
+// @Success 200 {object} DefaultResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /interviews/{id} [put]
+// @Security ApiKeyAuth
+func RescheduleInterview(c *gin.Context) {
+	ip := c.ClientIP()
+	idStr := c.Param("id")
+	logger.Info.Printf("[controllers.RescheduleInterview] Client IP: %s - Request to reschedule interview %s\n", ip, idStr)
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		handleError(c, errs.ErrIDIsNotCorrect)
+		return
+	}
+	var request models.SwagInterview
+	if err := c.ShouldBindJSON(&request); err != nil {
+		logger.Error.Printf("[controllers.RescheduleInterview] Client IP: %s - Error parsing interview data: %v\n", ip, err)
+		handleError(c, errs.ErrShouldBindJson)
+		return
+	}
+	interview, err := interviewFromRequest(request)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+	userID, err := service.GetUserIDFromToken(c)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	if err := service.RescheduleInterview(userID, uint(id), interview); err != nil {
+		handleError(c, err)
+		return
+	}
+	logger.Info.Printf("[controllers.RescheduleInterview] Client IP: %s - Successfully rescheduled interview %v\n", ip, id)
+	c.JSON(http.StatusOK, NewDefaultResponse("Interview rescheduled successfully"))
+}
+
+// SetInterviewOutcome godoc
+// @Summary Set the outcome of an interview
+// @Description Record the outcome of a pending interview: passed, failed, no_show or cancelled. Feedback is visible only to the employer.
+// @Tags Interviews
+// @Accept json
+// @Produce json
+// @Param id path integer true "Interview ID"
+// @Param outcome body models.SwagInterviewOutcome true "Interview outcome"
+// @Success 200 {object} DefaultResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /interviews/{id}/outcome [patch]
+// @Security ApiKeyAuth
+func SetInterviewOutcome(c *gin.Context) {
+	ip := c.ClientIP()
+	idStr := c.Param("id")
+	logger.Info.Printf("[controllers.SetInterviewOutcome] Client IP: %s - Request to set outcome of interview %s\n", ip, idStr)
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		handleError(c, errs.ErrIDIsNotCorrect)
+		return
+	}
+	var request models.SwagInterviewOutcome
+	if err := c.ShouldBindJSON(&request); err != nil {
+		logger.Error.Printf("[controllers.SetInterviewOutcome] Client IP: %s - Error parsing outcome: %v\n", ip, err)
+		handleError(c, errs.ErrShouldBindJson)
+		return
+	}
+	userID, err := service.GetUserIDFromToken(c)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	if err := service.SetInterviewOutcome(userID, uint(id), request.Outcome, request.Feedback); err != nil {
+		handleError(c, err)
+		return
+	}
+	logger.Info.Printf("[controllers.SetInterviewOutcome] Client IP: %s - Successfully set outcome of interview %v to %s\n", ip, id, request.Outcome)
+	c.JSON(http.StatusOK, NewDefaultResponse("Interview outcome saved successfully"))
+}
+
+// ExportInterviewICS godoc
+// @Summary Export an interview to a calendar
+// @Description Download the interview as an iCalendar (.ics) file. Available to the applicant, the vacancy author and members of its company.
+// @Tags Interviews
+// @Produce text/calendar
+// @Param id path integer true "Interview ID"
+// @Success 200 {file} file
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /interviews/{id}/ics [get]
+// @Security ApiKeyAuth
+func ExportInterviewICS(c *gin.Context) {
+	ip := c.ClientIP()
+	idStr := c.Param("id")
+	logger.Info.Printf("[controllers.ExportInterviewICS] Client IP: %s - Request to export interview %s\n", ip, idStr)
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		handleError(c, errs.ErrIDIsNotCorrect)
+		return
+	}
+	userID, err := service.GetUserIDFromToken(c)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	data, err := service.GetInterviewICS(userID, uint(id))
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+	logger.Info.Printf("[controllers.ExportInterviewICS] Client IP: %s - Successfully exported interview %v\n", ip, id)
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=interview-%d.ics", id))
+	c.Data(http.StatusOK, "text/calendar; charset=utf-8", data)
+}
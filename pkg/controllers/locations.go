@@ -0,0 +1,96 @@
+package controllers
+
+import (
+	"TajikCareerHub/logger"
+	"TajikCareerHub/models"
+	"TajikCareerHub/pkg/service"
+	"TajikCareerHub/utils/errs"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"strconv"
+)
+
+// GetLocations godoc
+// @Summary      Suggest locations
+// @Description  Autocomplete locations from the dictionary of regions, cities and districts. search matches any part of the Tajik, Russian or English name, names starting with it come first
+// @Tags         Locations
+// @Accept       json
+// @Produce      json
+// @Param        search     query   string  false  "Part of the location name in any language"
+// @Param        parent-id  query   int     false  "Only the places directly inside this location"
+// @Param        type       query   string  false  "Location type: region, city or district"
+// @Param        limit      query   int     false  "Number of suggestions, 10 by default, up to 50"
+// @Success      200  {array}   models.Location  "Success"
+// @Failure      400  {object}  ErrorResponse  "Invalid request"
+// @Failure      403  {object}  ErrorResponse  "Access Denied"
+// @Failure      500  {object}  ErrorResponse  "Internal server error"
+// @Security     ApiKeyAuth
+// @Router       /locations [get]
+func GetLocations(c *gin.Context) {
+	ip := c.ClientIP()
+	query := models.LocationQuery{
+		Search: c.Query("search"),
+		Type:   c.Query("type"),
+	}
+	logger.Info.Printf("[controllers.GetLocations] Client IP: %s - Client requested locations with search: %s\n", ip, query.Search)
+
+	if parentIDStr := c.Query("parent-id"); parentIDStr != "" {
+		parentID, err := strconv.ParseUint(parentIDStr, 10, 32)
+		if err != nil {
+			handleError(c, errs.ErrIDIsNotCorrect)
+			return
+		}
+		id := uint(parentID)
+		query.ParentID = &id
+	}
+	if limitStr := c.Query("limit"); limitStr != "" {
+		limit, err := strconv.Atoi(limitStr)
+		if err != nil || limit < 1 {
+			handleError(c, errs.ErrIncorrectInput)
+			return
+		}
+		query.Limit = limit
+	}
+
+	locations, err := service.SearchLocations(query)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	logger.Info.Printf("[controllers.GetLocations] Client IP: %s - Successfully retrieved %d locations\n", ip, len(locations))
+	c.JSON(http.StatusOK, locations)
+}
+
+// GetLocationByID godoc
+// @Summary      Get location by ID
+// @Description  Get a location from the dictionary with the location it belongs to
+// @Tags         Locations
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "Location ID"
+// @Success      200  {object}  models.Location  "Success"
+// @Failure      400  {object}  ErrorResponse  "Invalid ID"
+// @Failure      403  {object}  ErrorResponse  "Access Denied"
+// @Failure      404  {object}  ErrorResponse  "Location not found"
+// @Security     ApiKeyAuth
+// @Router       /locations/{id} [get]
+func GetLocationByID(c *gin.Context) {
+	ip := c.ClientIP()
+	idStr := c.Param("id")
+	logger.Info.Printf("[controllers.GetLocationByID] Client IP: %s - Client requested location with ID: %s\n", ip, idStr)
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		handleError(c, errs.ErrIDIsNotCorrect)
+		return
+	}
+
+	location, err := service.GetLocationByID(uint(id))
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	logger.Info.Printf("[controllers.GetLocationByID] Client IP: %s - Successfully retrieved location with ID: %v\n", ip, id)
+	c.JSON(http.StatusOK, location)
+}
@@ -0,0 +1,123 @@
+package controllers
+
+import (
+	"TajikCareerHub/logger"
+	"TajikCareerHub/pkg/service"
+	"TajikCareerHub/utils/errs"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"strconv"
+)
+
+// parseMinScore reads the optional min-score query parameter, a score from 0 to 100.
+func parseMinScore(c *gin.Context) (minScore float64, err error) {
+	minScoreStr := c.Query("min-score")
+	if minScoreStr == "" {
+		return 0, nil
+	}
+	minScore, err = strconv.ParseFloat(minScoreStr, 64)
+	if err != nil || minScore < 0 || minScore > 100 {
+		return 0, errs.ErrIncorrectInput
+	}
+	return minScore, nil
+}
+
+// GetVacancyCandidates godoc
+// @Summary Get candidates for a vacancy
+// @Description Rank resumes for a vacancy by category, location, salary expectation, experience, skills and text similarity. Every match explains its score with a breakdown per criterion. Available to the vacancy author and members of its company.
+// @Tags Matching
+// @Accept json
+// @Produce json
+// @Param vacancyID path integer true "Vacancy ID"
+// @Param min-score query number false "Minimum score from 0 to 100"
+// @Param page query integer false "Page number, starting from 1"
+// @Param size query integer false "Page size, up to 100"
+// @Success 200 {object} PageResponse[models.Match]
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /vacancies/{vacancyID}/candidates [get]
+// @Security ApiKeyAuth
+func GetVacancyCandidates(c *gin.Context) {
+	ip := c.ClientIP()
+	idStr := c.Param("vacancyID")
+	logger.Info.Printf("[controllers.GetVacancyCandidates] Client IP: %s - Request to get candidates for vacancy %s\n", ip, idStr)
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		handleError(c, errs.ErrIDIsNotCorrect)
+		return
+	}
+	minScore, err := parseMinScore(c)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+	params, err := parsePageParams(c)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+	userID, err := service.GetUserIDFromToken(c)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	matches, info, err := service.GetVacancyCandidates(userID, uint(id), minScore, params)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+	logger.Info.Printf("[controllers.GetVacancyCandidates] Client IP: %s - Successfully retrieved candidates for vacancy %v\n", ip, id)
+	c.JSON(http.StatusOK, NewPageResponse(matches, params, info))
+}
+
+// GetResumeRecommendations godoc
+// @Summary Get recommended vacancies for a resume
+// @Description Rank vacancies for a resume by category, location, salary expectation, experience, skills and text similarity. Every match explains its score with a breakdown per criterion. Available to the resume owner.
+// @Tags Matching
+// @Accept json
+// @Produce json
+// @Param id path integer true "Resume ID"
+// @Param min-score query number false "Minimum score from 0 to 100"
+// @Param page query integer false "Page number, starting from 1"
+// @Param size query integer false "Page size, up to 100"
+// @Success 200 {object} PageResponse[models.Match]
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /resumes/{id}/recommendations [get]
+// @Security ApiKeyAuth
+func GetResumeRecommendations(c *gin.Context) {
+	ip := c.ClientIP()
+	idStr := c.Param("id")
+	logger.Info.Printf("[controllers.GetResumeRecommendations] Client IP: %s - Request to get recommendations for resume %s\n", ip, idStr)
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		handleError(c, errs.ErrIDIsNotCorrect)
+		return
+	}
+	minScore, err := parseMinScore(c)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+	params, err := parsePageParams(c)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+	userID, err := service.GetUserIDFromToken(c)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	matches, info, err := service.GetResumeRecommendations(userID, uint(id), minScore, params)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+	logger.Info.Printf("[controllers.GetResumeRecommendations] Client IP: %s - Successfully retrieved recommendations for resume %v\n", ip, id)
+	c.JSON(http.StatusOK, NewPageResponse(matches, params, info))
+}
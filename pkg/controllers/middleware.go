@@ -1,8 +1,10 @@
 package controllers
 
 import (
+	"TajikCareerHub/logger"
 	"TajikCareerHub/pkg/service"
-	"fmt"
+	"TajikCareerHub/utils/errs"
+	"errors"
 	"github.com/gin-gonic/gin"
 	"net/http"
 	"strings"
@@ -41,8 +43,27 @@ func checkUserAuthentication(c *gin.Context) {
 		c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
 		return
 	}
-	fmt.Println("UserID:", claims.UserID, "Role:", claims.RoleID)
+	if err := service.ValidateTokenClaims(claims); err != nil {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+	logger.Debug.Printf("[controllers.checkUserAuthentication] Authenticated user with ID %d and role %d\n", claims.UserID, claims.RoleID)
 	c.Set(userIDCtx, claims.UserID)
 	c.Set(userRoleCtx, claims.RoleID)
 	c.Next()
 }
+
+func checkPermission(permission string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		err := service.CheckPermission(c.GetUint(userRoleCtx), permission)
+		if err != nil {
+			if errors.Is(err, errs.ErrAccessDenied) {
+				c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": err.Error()})
+				return
+			}
+			c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": errs.ErrSomethingWentWrong.Error()})
+			return
+		}
+		c.Next()
+	}
+}
@@ -0,0 +1,91 @@
+package controllers
+
+import (
+	"TajikCareerHub/logger"
+	"TajikCareerHub/pkg/service"
+	"TajikCareerHub/utils/errs"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"strconv"
+)
+
+// GetMyNotifications godoc
+// @Summary Get my notifications
+// @Description Get the notifications of the current user, the newest first.
+// @Tags Notifications
+// @Accept json
+// @Produce json
+// @Param unread query boolean false "Only notifications that weren't read yet"
+// @Param sort query string false "Comma separated sort fields: created_at, prefix with - for descending"
+// @Param page query integer false "Page number, starting from 1"
+// @Param size query integer false "Page size, up to 100"
+// @Param cursor query string false "Cursor of the next page returned by the previous request"
+// @Success 200 {object} PageResponse[models.Notification]
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Router /notifications [get]
+// @Security ApiKeyAuth
+func GetMyNotifications(c *gin.Context) {
+	ip := c.ClientIP()
+	logger.Info.Printf("[controllers.GetMyNotifications] Client IP: %s - Request to get notifications\n", ip)
+	userID, err := service.GetUserIDFromToken(c)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+	params, err := parsePageParams(c)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+	unreadOnly := false
+	if unreadStr := c.Query("unread"); unreadStr != "" {
+		if unreadOnly, err = strconv.ParseBool(unreadStr); err != nil {
+			handleError(c, errs.ErrIncorrectInput)
+			return
+		}
+	}
+
+	notifications, info, err := service.GetMyNotifications(userID, unreadOnly, params)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+	logger.Info.Printf("[controllers.GetMyNotifications] Client IP: %s - Successfully retrieved notifications of user %v\n", ip, userID)
+	c.JSON(http.StatusOK, NewPageResponse(notifications, params, info))
+}
+
+// MarkNotificationRead godoc
+// @Summary Mark a notification as read
+// @Tags Notifications
+// @Accept json
+// @Produce json
+// @Param id path integer true "Notification ID"
+// @Success 200 {object} DefaultResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /notifications/{id}/read [patch]
+// @Security ApiKeyAuth
+func MarkNotificationRead(c *gin.Context) {
+	ip := c.ClientIP()
+	idStr := c.Param("id")
+	logger.Info.Printf("[controllers.MarkNotificationRead] Client IP: %s - Request to mark notification %s as read\n", ip, idStr)
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		handleError(c, errs.ErrIDIsNotCorrect)
+		return
+	}
+	userID, err := service.GetUserIDFromToken(c)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	if err := service.MarkNotificationRead(userID, uint(id)); err != nil {
+		handleError(c, err)
+		return
+	}
+	logger.Info.Printf("[controllers.MarkNotificationRead] Client IP: %s - Successfully marked notification %v as read\n", ip, id)
+	c.JSON(http.StatusOK, NewDefaultResponse("Notification marked as read"))
+}
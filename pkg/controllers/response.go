@@ -1,5 +1,7 @@
 package controllers
 
+import "TajikCareerHub/models"
+
 type DefaultResponse struct {
 	Message string `json:"message"`
 }
@@ -11,7 +13,8 @@ func NewDefaultResponse(message string) DefaultResponse {
 }
 
 type AccessTokenResponse struct {
-	AccessToken string `json:"access_token"`
+	AccessToken  string `json:"access_token"`
+	RefreshToken string `json:"refresh_token"`
 }
 
 type ErrorResponse struct {
@@ -28,3 +31,31 @@ type PasswordRequest struct {
 	OldPassword string `json:"old_password" binding:"required"`
 	NewPassword string `json:"new_password" binding:"required"`
 }
+
+type RefreshTokenRequest struct {
+	RefreshToken string `json:"refresh_token" binding:"required"`
+}
+
+type PageResponse[T any] struct {
+	Items      []T    `json:"items"`
+	Total      int64  `json:"total"`
+	Page       int    `json:"page,omitempty"`
+	Size       int    `json:"size"`
+	NextCursor string `json:"next_cursor,omitempty"`
+}
+
+func NewPageResponse[T any](items []T, params models.PageParams, info models.PageInfo) PageResponse[T] {
+	if items == nil {
+		items = []T{}
+	}
+	response := PageResponse[T]{
+		Items:      items,
+		Total:      info.Total,
+		Size:       params.Size,
+		NextCursor: info.NextCursor,
+	}
+	if params.Cursor == "" {
+		response.Page = params.Page
+	}
+	return response
+}
@@ -12,15 +12,21 @@ import (
 
 // GetAllResumes godoc
 // @Summary      Get all resumes
-// @Description  Retrieves a list of resumes with optional filters such as search term, location, category, and minimum experience years.
+// @Description  Retrieves a list of resumes with optional filters such as search term, location, category, minimum experience years and skills.
 // @Tags         Resumes
 // @Accept       json
 // @Produce      json
 // @Param        search                query   string  false  "Search term"
-// @Param        location              query   string  false  "Location"
+// @Param        location              query   string  false  "Location name in any language, resumes in the places inside it match too"
+// @Param        location-id           query   int     false  "Location ID from the locations dictionary, resumes in the places inside it match too"
 // @Param        category              query   string  false  "Category"
 // @Param        min-experience-years  query   int     false  "Minimum years of experience"
-// @Success      200  {array}   models.SwagResume   "Success"  "List of resumes"
+// @Param        skills                query   string  false  "Comma separated skills, names or aliases from the skills dictionary, all are required"
+// @Param        sort                  query   string  false  "Comma separated sort fields: created_at, experience_years, title, prefix with - for descending"
+// @Param        page                  query   int     false  "Page number, starting from 1"
+// @Param        size                  query   int     false  "Page size, up to 100"
+// @Param        cursor                query   string  false  "Cursor of the next page returned by the previous request"
+// @Success      200  {object}  PageResponse[models.Resume]  "List of resumes"
 // @Failure      400  {object}  ErrorResponse  "Invalid request"
 // @Failure      403  {object}  ErrorResponse  "Access Denied"
 // @Failure      500  {object}  ErrorResponse  "Internal server error"
@@ -50,15 +56,31 @@ func GetAllResumes(c *gin.Context) {
 		handleError(c, err)
 		return
 	}
+	params, err := parsePageParams(c)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
 
-	resumes, err := service.GetAllResumes(search, minExperienceYears, location, category, userID)
+	filter := models.ResumeFilter{
+		Search:             search,
+		MinExperienceYears: minExperienceYears,
+		Location:           location,
+		Category:           category,
+		Skills:             parseSkillsQuery(c),
+	}
+	if filter.LocationID, err = parseLocationIDQuery(c); err != nil {
+		handleError(c, err)
+		return
+	}
+	resumes, info, err := service.GetAllResumes(filter, userID, params)
 	if err != nil {
 		handleError(c, err)
 		return
 	}
 
 	logger.Info.Printf("[controllers.GetAllResumes] Client IP: %s - Successfully retrieved resumes with search: %s, minExperienceYears: %d, location: %s, category: %s", ip, search, minExperienceYears, location, category)
-	c.JSON(http.StatusOK, resumes)
+	c.JSON(http.StatusOK, NewPageResponse(resumes, params, info))
 }
 
 // GetResumeByID godoc
@@ -100,7 +122,7 @@ func GetResumeByID(c *gin.Context) {
 
 // AddResume godoc
 // @Summary      Add a new resume
-// @Description  Adds a new resume to the system for the authenticated user.
+// @Description  Adds a new resume to the system for the authenticated user. When experience, education_history or skill_tags are sent, the flat experience_years, education and skills fields are derived from them.
 // @Tags         Resumes
 // @Accept       json
 // @Produce      json
@@ -142,7 +164,7 @@ func AddResume(c *gin.Context) {
 // @Accept       json
 // @Produce      json
 // @Param        id      path    int             true    "Resume ID"
-// @Param        resume  body   models.Resume  true  "Updated resume object"
+// @Param        resume  body   models.SwagResume  true  "Updated resume object. Experience, education_history and skill_tags replace the whole section when sent"
 // @Success      200  {object}  DefaultResponse  "Success"
 // @Failure      400  {object}  ErrorResponse  "Invalid ID or request"
 // @Failure      403  {object}  ErrorResponse  "Access Denied"
@@ -168,13 +190,13 @@ func UpdateResume(c *gin.Context) {
 	var updatedResume models.Resume
 	if err := c.BindJSON(&updatedResume); err != nil {
 		logger.Error.Printf("[controllers.UpdateResume] Client IP: %s - Error parsing resume JSON: %v", ip, err)
-		handleError(c, err)
+		handleError(c, errs.ErrShouldBindJson)
 		return
 	}
 
 	err = service.UpdateResume(uint(id), updatedResume, userID)
 	if err != nil {
-		handleError(c, errs.ErrShouldBindJson)
+		handleError(c, err)
 		return
 	}
 
@@ -251,13 +273,8 @@ func BlockResume(c *gin.Context) {
 		handleError(c, err)
 		return
 	}
-	RoleID, err := service.GetRoleIDFromToken(c)
-	if err != nil {
-		handleError(c, err)
-		return
-	}
 
-	err = service.BlockResume(uint(id), userID, RoleID)
+	err = service.BlockResume(uint(id), userID)
 	if err != nil {
 		handleError(c, err)
 		return
@@ -296,13 +313,8 @@ func UnblockResume(c *gin.Context) {
 		handleError(c, err)
 		return
 	}
-	RoleID, err := service.GetRoleIDFromToken(c)
-	if err != nil {
-		handleError(c, err)
-		return
-	}
 
-	err = service.UnblockResume(uint(id), userID, RoleID)
+	err = service.UnblockResume(uint(id), userID)
 	if err != nil {
 		handleError(c, err)
 		return
@@ -4,6 +4,7 @@ import (
 	"TajikCareerHub/configs"
 	_ "TajikCareerHub/docs"
 	"TajikCareerHub/logger"
+	"TajikCareerHub/models"
 	"fmt"
 	"github.com/gin-gonic/gin"
 	swaggerFiles "github.com/swaggo/files"
@@ -21,6 +22,8 @@ func InitRoutes() *gin.Engine {
 	{
 		auth.POST("/sign-up", SignUp)
 		auth.POST("/sign-in", SignIn)
+		auth.POST("/refresh", RefreshTokens)
+		auth.POST("/sign-out", SignOut)
 	}
 
 	userGroup := r.Group("/users").Use(checkUserAuthentication)
@@ -31,53 +34,112 @@ func InitRoutes() *gin.Engine {
 		userGroup.GET("/:id", GetUserByID)
 		userGroup.DELETE("/:id", DeleteUser)
 		userGroup.PATCH("/password", UpdateUserPassword)
-		userGroup.PATCH("/block/:id", BlockUser)
-		userGroup.PATCH("/unblock/:id", UnblockUser)
+		userGroup.PATCH("/block/:id", checkPermission(models.PermissionUserBlock), BlockUser)
+		userGroup.PATCH("/unblock/:id", checkPermission(models.PermissionUserBlock), UnblockUser)
+		userGroup.DELETE("/sessions/:id", checkPermission(models.PermissionUserSessionRevoke), RevokeUserSessions)
 	}
 
 	vacancyGroup := r.Group("/vacancies").Use(checkUserAuthentication)
 	{
 		vacancyGroup.GET("/", GetAllVacancies)
 		vacancyGroup.GET("/:vacancyID", GetVacancyByID)
-		vacancyGroup.POST("/", AddVacancy)
-		vacancyGroup.PUT("/:id", UpdateVacancy)
-		vacancyGroup.DELETE("/:id", DeleteVacancy)
-		vacancyGroup.DELETE("/block/:id", BlockVacancy)
-		vacancyGroup.PATCH("/unblock/:id", UnblockVacancy)
+		vacancyGroup.GET("/:vacancyID/applications", checkPermission(models.PermissionApplicationStatus), GetVacancyApplications)
+		vacancyGroup.GET("/:vacancyID/candidates", checkPermission(models.PermissionVacancyWrite), GetVacancyCandidates)
+		vacancyGroup.POST("/", checkPermission(models.PermissionVacancyWrite), AddVacancy)
+		vacancyGroup.PUT("/:vacancyID", checkPermission(models.PermissionVacancyWrite), UpdateVacancy)
+		vacancyGroup.DELETE("/:vacancyID", checkPermission(models.PermissionVacancyWrite), DeleteVacancy)
+		vacancyGroup.PATCH("/:vacancyID/publish", checkPermission(models.PermissionVacancyWrite), PublishVacancy)
+		vacancyGroup.PATCH("/:vacancyID/pause", checkPermission(models.PermissionVacancyWrite), PauseVacancy)
+		vacancyGroup.PATCH("/:vacancyID/close", checkPermission(models.PermissionVacancyWrite), CloseVacancy)
+		vacancyGroup.DELETE("/block/:id", checkPermission(models.PermissionVacancyBlock), BlockVacancy)
+		vacancyGroup.PATCH("/unblock/:id", checkPermission(models.PermissionVacancyBlock), UnblockVacancy)
 	}
 
 	resumeGroup := r.Group("/resumes").Use(checkUserAuthentication)
 	{
 		resumeGroup.GET("/", GetAllResumes)
 		resumeGroup.GET("/:id", GetResumeByID)
-		resumeGroup.POST("/", AddResume)
-		resumeGroup.PUT("/:id", UpdateResume)
-		resumeGroup.DELETE("/:id", DeleteResume)
-		resumeGroup.PATCH("/block/:id", BlockResume)
-		resumeGroup.PATCH("/unblock/:id", UnblockResume)
+		resumeGroup.GET("/:id/recommendations", checkPermission(models.PermissionResumeWrite), GetResumeRecommendations)
+		resumeGroup.POST("/", checkPermission(models.PermissionResumeWrite), AddResume)
+		resumeGroup.PUT("/:id", checkPermission(models.PermissionResumeWrite), UpdateResume)
+		resumeGroup.DELETE("/:id", checkPermission(models.PermissionResumeWrite), DeleteResume)
+		resumeGroup.PATCH("/block/:id", checkPermission(models.PermissionResumeBlock), BlockResume)
+		resumeGroup.PATCH("/unblock/:id", checkPermission(models.PermissionResumeBlock), UnblockResume)
 	}
 
 	companyGroup := r.Group("/companies").Use(checkUserAuthentication)
 	{
 		companyGroup.GET("/", GetAllCompanies)
 		companyGroup.GET("/:id", GetCompanyByID)
-		companyGroup.POST("/", AddCompany)
-		companyGroup.PUT("/:id", UpdateCompany)
-		companyGroup.DELETE("/:id", DeleteCompany)
+		companyGroup.POST("/", checkPermission(models.PermissionCompanyWrite), AddCompany)
+		companyGroup.PUT("/:id", checkPermission(models.PermissionCompanyWrite), UpdateCompany)
+		companyGroup.DELETE("/:id", checkPermission(models.PermissionCompanyWrite), DeleteCompany)
+		companyGroup.PATCH("/verify/:id", checkPermission(models.PermissionCompanyVerify), VerifyCompany)
+		companyGroup.PATCH("/block/:id", checkPermission(models.PermissionCompanyBlock), BlockCompany)
+		companyGroup.PATCH("/unblock/:id", checkPermission(models.PermissionCompanyBlock), UnblockCompany)
+		companyGroup.GET("/:id/members", GetCompanyMembers)
+		companyGroup.POST("/:id/invitations", checkPermission(models.PermissionCompanyWrite), InviteCompanyMember)
+		companyGroup.DELETE("/:id/members/:user_id", checkPermission(models.PermissionCompanyWrite), RemoveCompanyMember)
+		companyGroup.GET("/invitations", checkPermission(models.PermissionCompanyWrite), GetMyCompanyInvitations)
+		companyGroup.PATCH("/invitations/:id/accept", checkPermission(models.PermissionCompanyWrite), AcceptCompanyInvitation)
+		companyGroup.PATCH("/invitations/:id/decline", checkPermission(models.PermissionCompanyWrite), DeclineCompanyInvitation)
 	}
 
 	applicationGroup := r.Group("/applications").Use(checkUserAuthentication)
 	{
 		applicationGroup.GET("/", GetAllApplications)
+		applicationGroup.GET("/received", checkPermission(models.PermissionApplicationStatus), GetReceivedApplications)
 		applicationGroup.GET("/:application_id", GetApplicationByID) // Измените :id на :application_id
-		applicationGroup.POST("/", AddApplication)
+		applicationGroup.POST("/", checkPermission(models.PermissionApplicationWrite), AddApplication)
 		applicationGroup.PUT("/:application_id", UpdateApplication)    // Измените :id на :application_id
 		applicationGroup.DELETE("/:application_id", DeleteApplication) // Измените :id на :application_id
+		applicationGroup.GET("/:application_id/history", GetApplicationStatusHistory)
+		applicationGroup.PATCH("/:application_id/withdraw", checkPermission(models.PermissionApplicationWrite), WithdrawApplication)
+		applicationGroup.GET("/:application_id/notes", checkPermission(models.PermissionApplicationStatus), GetApplicationNotes)
+		applicationGroup.POST("/:application_id/notes", checkPermission(models.PermissionApplicationStatus), AddApplicationNote)
+		applicationGroup.DELETE("/:application_id/notes/:note_id", checkPermission(models.PermissionApplicationStatus), DeleteApplicationNote)
+		applicationGroup.PATCH("/:application_id/rating", checkPermission(models.PermissionApplicationStatus), RateApplication)
+		applicationGroup.GET("/:application_id/interviews", GetApplicationInterviews)
+		applicationGroup.POST("/:application_id/interviews", checkPermission(models.PermissionApplicationStatus), ScheduleInterview)
 	}
 
-	statusGroup := r.Group("/applications/:application_id/status")
+	statusGroup := r.Group("/applications/:application_id/status").Use(checkUserAuthentication)
 	{
-		statusGroup.PUT("/:status_id", UpdateApplicationStatus)
+		statusGroup.PUT("/:status_id", checkPermission(models.PermissionApplicationStatus), UpdateApplicationStatus)
+	}
+
+	interviewGroup := r.Group("/interviews").Use(checkUserAuthentication)
+	{
+		interviewGroup.PUT("/:id", checkPermission(models.PermissionApplicationStatus), RescheduleInterview)
+		interviewGroup.PATCH("/:id/outcome", checkPermission(models.PermissionApplicationStatus), SetInterviewOutcome)
+		interviewGroup.GET("/:id/ics", ExportInterviewICS)
+	}
+
+	meGroup := r.Group("/me").Use(checkUserAuthentication)
+	{
+		meGroup.GET("/applications", GetMyApplications)
+		meGroup.GET("/vacancies", checkPermission(models.PermissionVacancyWrite), GetMyVacancies)
+		meGroup.GET("/favorites", checkPermission(models.PermissionApplicationWrite), GetMyVacancyFavorites)
+		meGroup.PUT("/favorites/:vacancyID", checkPermission(models.PermissionApplicationWrite), SaveVacancyFavorite)
+		meGroup.DELETE("/favorites/:vacancyID", checkPermission(models.PermissionApplicationWrite), RemoveVacancyFavorite)
+		meGroup.GET("/shortlist", checkPermission(models.PermissionApplicationStatus), GetMyResumeShortlist)
+		meGroup.PUT("/shortlist/:resumeID", checkPermission(models.PermissionApplicationStatus), SaveResumeShortlistItem)
+		meGroup.DELETE("/shortlist/:resumeID", checkPermission(models.PermissionApplicationStatus), RemoveResumeShortlistItem)
+	}
+
+	notificationGroup := r.Group("/notifications").Use(checkUserAuthentication)
+	{
+		notificationGroup.GET("/", GetMyNotifications)
+		notificationGroup.PATCH("/:id/read", MarkNotificationRead)
+	}
+
+	savedSearchGroup := r.Group("/saved-searches").Use(checkUserAuthentication)
+	{
+		savedSearchGroup.GET("/", GetMySavedSearches)
+		savedSearchGroup.GET("/:id", GetSavedSearchByID)
+		savedSearchGroup.POST("/", AddSavedSearch)
+		savedSearchGroup.PUT("/:id", UpdateSavedSearch)
+		savedSearchGroup.DELETE("/:id", DeleteSavedSearch)
 	}
 
 	activityGroup := r.Group("/activities").Use(checkUserAuthentication)
@@ -91,9 +153,24 @@ func InitRoutes() *gin.Engine {
 	{
 		VacancyCategoryGroup.GET("/", GetAllCategories)
 		VacancyCategoryGroup.GET("/:id", GetCategoryByID)
-		VacancyCategoryGroup.POST("/", CreateCategory)
-		VacancyCategoryGroup.PUT("/:id", UpdateCategory)
-		VacancyCategoryGroup.DELETE("/:id", DeleteCategory)
+		VacancyCategoryGroup.POST("/", checkPermission(models.PermissionCategoryWrite), CreateCategory)
+		VacancyCategoryGroup.PUT("/:id", checkPermission(models.PermissionCategoryWrite), UpdateCategory)
+		VacancyCategoryGroup.DELETE("/:id", checkPermission(models.PermissionCategoryWrite), DeleteCategory)
+	}
+
+	skillGroup := r.Group("/skills").Use(checkUserAuthentication)
+	{
+		skillGroup.GET("/", GetAllSkills)
+		skillGroup.GET("/:id", GetSkillByID)
+		skillGroup.POST("/", checkPermission(models.PermissionSkillWrite), CreateSkill)
+		skillGroup.PUT("/:id", checkPermission(models.PermissionSkillWrite), UpdateSkill)
+		skillGroup.DELETE("/:id", checkPermission(models.PermissionSkillWrite), DeleteSkill)
+	}
+
+	locationGroup := r.Group("/locations").Use(checkUserAuthentication)
+	{
+		locationGroup.GET("/", GetLocations)
+		locationGroup.GET("/:id", GetLocationByID)
 	}
 
 	if err := r.Run(fmt.Sprintf("%s:%s", configs.AppSettings.AppParams.ServerURL, configs.AppSettings.AppParams.PortRun)); err != nil {
@@ -0,0 +1,197 @@
+package controllers
+
+import (
+	"TajikCareerHub/logger"
+	"TajikCareerHub/models"
+	"TajikCareerHub/pkg/service"
+	"TajikCareerHub/utils/errs"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"strconv"
+)
+
+// GetMySavedSearches godoc
+// @Summary Get my saved searches
+// @Description Get the vacancy searches saved by the current user.
+// @Tags Saved searches
+// @Accept json
+// @Produce json
+// @Param sort query string false "Comma separated sort fields: created_at, name, prefix with - for descending"
+// @Param page query integer false "Page number, starting from 1"
+// @Param size query integer false "Page size, up to 100"
+// @Param cursor query string false "Cursor of the next page returned by the previous request"
+// @Success 200 {object} PageResponse[models.SavedSearch]
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Router /saved-searches [get]
+// @Security ApiKeyAuth
+func GetMySavedSearches(c *gin.Context) {
+	ip := c.ClientIP()
+	logger.Info.Printf("[controllers.GetMySavedSearches] Client IP: %s - Request to get saved searches\n", ip)
+	userID, err := service.GetUserIDFromToken(c)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+	params, err := parsePageParams(c)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	searches, info, err := service.GetMySavedSearches(userID, params)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+	logger.Info.Printf("[controllers.GetMySavedSearches] Client IP: %s - Successfully retrieved saved searches of user %v\n", ip, userID)
+	c.JSON(http.StatusOK, NewPageResponse(searches, params, info))
+}
+
+// GetSavedSearchByID godoc
+// @Summary Get a saved search
+// @Tags Saved searches
+// @Accept json
+// @Produce json
+// @Param id path integer true "Saved search ID"
+// @Success 200 {object} models.SavedSearch
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /saved-searches/{id} [get]
+// @Security ApiKeyAuth
+func GetSavedSearchByID(c *gin.Context) {
+	ip := c.ClientIP()
+	idStr := c.Param("id")
+	logger.Info.Printf("[controllers.GetSavedSearchByID] Client IP: %s - Request to get saved search %s\n", ip, idStr)
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		handleError(c, errs.ErrIDIsNotCorrect)
+		return
+	}
+	userID, err := service.GetUserIDFromToken(c)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	search, err := service.GetSavedSearchByID(userID, uint(id))
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+	logger.Info.Printf("[controllers.GetSavedSearchByID] Client IP: %s - Successfully retrieved saved search %v\n", ip, id)
+	c.JSON(http.StatusOK, search)
+}
+
+// AddSavedSearch godoc
+// @Summary Save a vacancy search
+// @Description Save the filters of the vacancy list. Every day or week, depending on the frequency, the user gets a digest of the vacancies published since the previous one that match the search.
+// @Tags Saved searches
+// @Accept json
+// @Produce json
+// @Param search body models.SwagSavedSearch true "Saved search"
+// @Success 201 {object} models.SavedSearch
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Router /saved-searches [post]
+// @Security ApiKeyAuth
+func AddSavedSearch(c *gin.Context) {
+	ip := c.ClientIP()
+	logger.Info.Printf("[controllers.AddSavedSearch] Client IP: %s - Request to save a search\n", ip)
+	userID, err := service.GetUserIDFromToken(c)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+	var input models.SwagSavedSearch
+	if err := c.ShouldBindJSON(&input); err != nil {
+		handleError(c, errs.ErrShouldBindJson)
+		return
+	}
+
+	search, err := service.AddSavedSearch(userID, input)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+	logger.Info.Printf("[controllers.AddSavedSearch] Client IP: %s - Successfully saved search %v\n", ip, search.ID)
+	c.JSON(http.StatusCreated, search)
+}
+
+// UpdateSavedSearch godoc
+// @Summary Update a saved search
+// @Description Replace the name, filters and frequency of a saved search. is_active turns the digests on and off, it is left as it is when not sent. A search turned back on collects the vacancies published from then on.
+// @Tags Saved searches
+// @Accept json
+// @Produce json
+// @Param id path integer true "Saved search ID"
+// @Param search body models.SwagSavedSearch true "Saved search"
+// @Success 200 {object} DefaultResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /saved-searches/{id} [put]
+// @Security ApiKeyAuth
+func UpdateSavedSearch(c *gin.Context) {
+	ip := c.ClientIP()
+	idStr := c.Param("id")
+	logger.Info.Printf("[controllers.UpdateSavedSearch] Client IP: %s - Request to update saved search %s\n", ip, idStr)
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		handleError(c, errs.ErrIDIsNotCorrect)
+		return
+	}
+	userID, err := service.GetUserIDFromToken(c)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+	var input models.SwagSavedSearch
+	if err := c.ShouldBindJSON(&input); err != nil {
+		handleError(c, errs.ErrShouldBindJson)
+		return
+	}
+
+	if err := service.UpdateSavedSearch(userID, uint(id), input); err != nil {
+		handleError(c, err)
+		return
+	}
+	logger.Info.Printf("[controllers.UpdateSavedSearch] Client IP: %s - Successfully updated saved search %v\n", ip, id)
+	c.JSON(http.StatusOK, NewDefaultResponse("Saved search updated successfully"))
+}
+
+// DeleteSavedSearch godoc
+// @Summary Delete a saved search
+// @Tags Saved searches
+// @Accept json
+// @Produce json
+// @Param id path integer true "Saved search ID"
+// @Success 200 {object} DefaultResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /saved-searches/{id} [delete]
+// @Security ApiKeyAuth
+func DeleteSavedSearch(c *gin.Context) {
+	ip := c.ClientIP()
+	idStr := c.Param("id")
+	logger.Info.Printf("[controllers.DeleteSavedSearch] Client IP: %s - Request to delete saved search %s\n", ip, idStr)
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		handleError(c, errs.ErrIDIsNotCorrect)
+		return
+	}
+	userID, err := service.GetUserIDFromToken(c)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	if err := service.DeleteSavedSearch(userID, uint(id)); err != nil {
+		handleError(c, err)
+		return
+	}
+	logger.Info.Printf("[controllers.DeleteSavedSearch] Client IP: %s - Successfully deleted saved search %v\n", ip, id)
+	c.JSON(http.StatusOK, NewDefaultResponse("Saved search deleted successfully"))
+}
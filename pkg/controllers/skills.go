@@ -0,0 +1,184 @@
+package controllers
+
+import (
+	"TajikCareerHub/logger"
+	"TajikCareerHub/models"
+	"TajikCareerHub/pkg/service"
+	"TajikCareerHub/utils/errs"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"strconv"
+)
+
+// GetAllSkills godoc
+// @Summary      Get all skills
+// @Description  Retrieve the skills dictionary. search matches the beginning of the name or an alias and any part of the localized names
+// @Tags         Skills
+// @Accept       json
+// @Produce      json
+// @Param        search  query   string  false  "Skill name, alias or localized name"
+// @Param        sort    query   string  false  "Comma separated sort fields: created_at, name, prefix with - for descending"
+// @Param        page    query   int     false  "Page number, starting from 1"
+// @Param        size    query   int     false  "Page size, up to 100"
+// @Param        cursor  query   string  false  "Cursor of the next page returned by the previous request"
+// @Success      200  {object}  PageResponse[models.Skill]  "Success"
+// @Failure      400  {object}  ErrorResponse  "Invalid request"
+// @Failure      403  {object}  ErrorResponse  "Access Denied"
+// @Failure      500  {object}  ErrorResponse  "Internal server error"
+// @Security     ApiKeyAuth
+// @Router       /skills [get]
+func GetAllSkills(c *gin.Context) {
+	ip := c.ClientIP()
+	search := c.Query("search")
+	logger.Info.Printf("[controllers.GetAllSkills] Client IP: %s - Client requested skills with search: %s\n", ip, search)
+
+	params, err := parsePageParams(c)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	skills, info, err := service.GetAllSkills(search, params)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	logger.Info.Printf("[controllers.GetAllSkills] Client IP: %s - Successfully retrieved skills\n", ip)
+	c.JSON(http.StatusOK, NewPageResponse(skills, params, info))
+}
+
+// GetSkillByID godoc
+// @Summary      Get skill by ID
+// @Description  Retrieve a skill with its aliases and localized names
+// @Tags         Skills
+// @Accept       json
+// @Produce      json
+// @Param        id  path    int     true    "Skill ID"
+// @Success      200  {object}  models.Skill   "Success"
+// @Failure      400  {object}  ErrorResponse  "Invalid ID"
+// @Failure      404  {object}  ErrorResponse  "Skill not found"
+// @Failure      403  {object}  ErrorResponse  "Access Denied"
+// @Failure      500  {object}  ErrorResponse  "Internal server error"
+// @Security     ApiKeyAuth
+// @Router       /skills/{id} [get]
+func GetSkillByID(c *gin.Context) {
+	ip := c.ClientIP()
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		logger.Error.Printf("[controllers.GetSkillByID] Error converting id to int: %s", err.Error())
+		handleError(c, errs.ErrIDIsNotCorrect)
+		return
+	}
+
+	skill, err := service.GetSkillByID(uint(id))
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	logger.Info.Printf("[controllers.GetSkillByID] Client IP: %s - Successfully retrieved skill with ID %v\n", ip, id)
+	c.JSON(http.StatusOK, skill)
+}
+
+// CreateSkill godoc
+// @Summary      Create a new skill
+// @Description  Add a skill to the dictionary. Resume skill tags matching its name or aliases are linked to it
+// @Tags         Skills
+// @Accept       json
+// @Produce      json
+// @Param        skill  body models.SwagSkill  true  "Skill data"
+// @Success      201  {object}  DefaultResponse  "Success"
+// @Failure      400  {object}  ErrorResponse  "Invalid input"
+// @Failure      403  {object}  ErrorResponse  "Access Denied"
+// @Failure      500  {object}  ErrorResponse  "Internal server error"
+// @Security     ApiKeyAuth
+// @Router       /skills [post]
+func CreateSkill(c *gin.Context) {
+	ip := c.ClientIP()
+	var skill models.Skill
+	if err := c.ShouldBindJSON(&skill); err != nil {
+		handleError(c, errs.ErrShouldBindJson)
+		return
+	}
+
+	if err := service.AddSkill(skill); err != nil {
+		handleError(c, err)
+		return
+	}
+
+	logger.Info.Printf("[controllers.CreateSkill] Client IP: %s - Successfully created skill %s\n", ip, skill.Name)
+	c.JSON(http.StatusCreated, NewDefaultResponse("Skill created successfully"))
+}
+
+// UpdateSkill godoc
+// @Summary      Update skill
+// @Description  Update the names of a skill and replace its aliases
+// @Tags         Skills
+// @Accept       json
+// @Produce      json
+// @Param        id  path    int     true    "Skill ID"
+// @Param        skill  body models.SwagSkill  true  "Updated skill data"
+// @Success      200  {object}  DefaultResponse  "Success"
+// @Failure      400  {object}  ErrorResponse  "Invalid ID or input"
+// @Failure      404  {object}  ErrorResponse  "Skill not found"
+// @Failure      403  {object}  ErrorResponse  "Access Denied"
+// @Failure      500  {object}  ErrorResponse  "Internal server error"
+// @Security     ApiKeyAuth
+// @Router       /skills/{id} [put]
+func UpdateSkill(c *gin.Context) {
+	ip := c.ClientIP()
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		handleError(c, errs.ErrIDIsNotCorrect)
+		return
+	}
+
+	var skill models.Skill
+	if err := c.ShouldBindJSON(&skill); err != nil {
+		handleError(c, errs.ErrShouldBindJson)
+		return
+	}
+
+	if err := service.UpdateSkill(uint(id), skill); err != nil {
+		handleError(c, err)
+		return
+	}
+
+	logger.Info.Printf("[controllers.UpdateSkill] Client IP: %s - Successfully updated skill with ID %v\n", ip, id)
+	c.JSON(http.StatusOK, NewDefaultResponse("Skill updated successfully"))
+}
+
+// DeleteSkill godoc
+// @Summary      Delete skill
+// @Description  Soft delete a skill and unlink it from vacancies and resumes
+// @Tags         Skills
+// @Accept       json
+// @Produce      json
+// @Param        id  path    int     true    "Skill ID"
+// @Success      200  {object}  DefaultResponse  "Success"
+// @Failure      400  {object}  ErrorResponse  "Invalid ID"
+// @Failure      404  {object}  ErrorResponse  "Skill not found"
+// @Failure      403  {object}  ErrorResponse  "Access Denied"
+// @Failure      500  {object}  ErrorResponse  "Internal server error"
+// @Security     ApiKeyAuth
+// @Router       /skills/{id} [delete]
+func DeleteSkill(c *gin.Context) {
+	ip := c.ClientIP()
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		handleError(c, errs.ErrIDIsNotCorrect)
+		return
+	}
+
+	if err := service.DeleteSkill(uint(id)); err != nil {
+		handleError(c, err)
+		return
+	}
+
+	logger.Info.Printf("[controllers.DeleteSkill] Client IP: %s - Successfully soft deleted skill with ID %v\n", ip, id)
+	c.JSON(http.StatusOK, NewDefaultResponse("Skill deleted successfully"))
+}
@@ -18,7 +18,11 @@ import (
 // @Accept       json
 // @Produce      json
 // @Param        username  query   string  false  "Username to filter the user"
-// @Success      200  {array}   models.SwagUser  "List of users"
+// @Param        sort      query   string  false  "Comma separated sort fields: created_at, full_name, user_name, prefix with - for descending"
+// @Param        page      query   int     false  "Page number, starting from 1"
+// @Param        size      query   int     false  "Page size, up to 100"
+// @Param        cursor    query   string  false  "Cursor of the next page returned by the previous request"
+// @Success      200  {object}  PageResponse[models.User]  "List of users"
 // @Success      200  {object}  models.User  "Single user details"
 // @Failure      403  {object}  ErrorResponse "Access Denied"
 // @Failure      500  {object}  ErrorResponse  "Internal server error"
@@ -37,18 +41,22 @@ func GetAllUsers(c *gin.Context) {
 		logger.Info.Printf("[controllers.GetAllUsers] Client IP: %s - Successfully retrieved user: %s.\n", ip, username)
 		c.JSON(http.StatusOK, user)
 	} else {
-		RoleID, err := service.GetRoleIDFromToken(c)
+		if err := service.CheckPermission(c.GetUint(userRoleCtx), models.PermissionUserList); err != nil {
+			handleError(c, err)
+			return
+		}
+		params, err := parsePageParams(c)
 		if err != nil {
 			handleError(c, err)
 			return
 		}
-		users, err := service.GetAllUsers(RoleID)
+		users, info, err := service.GetAllUsers(params)
 		if err != nil {
 			handleError(c, err)
 			return
 		}
 		logger.Info.Printf("[controllers.GetAllUsers] Client IP: %s - Successfully retrieved all users.\n", ip)
-		c.JSON(http.StatusOK, users)
+		c.JSON(http.StatusOK, NewPageResponse(users, params, info))
 	}
 }
 
@@ -199,7 +207,7 @@ func UpdateUser(c *gin.Context) {
 
 // DeleteUser godoc
 // @Summary      Delete user
-// @Description  Soft delete a user by ID
+// @Description  Soft delete a user by ID. Users can delete only their own account unless they can manage any resource
 // @Tags         Users
 // @Accept       json
 // @Produce      json
@@ -256,12 +264,7 @@ func BlockUser(c *gin.Context) {
 		c.JSON(http.StatusBadRequest, ErrorResponse{Error: idParam})
 		return
 	}
-	RoleID, err := service.GetRoleIDFromToken(c)
-	if err != nil {
-		handleError(c, err)
-		return
-	}
-	err = service.BlockUser(uint(id), RoleID)
+	err = service.BlockUser(uint(id))
 	if err != nil {
 		handleError(c, err)
 		return
@@ -293,18 +296,45 @@ func UnblockUser(c *gin.Context) {
 		c.JSON(http.StatusBadRequest, ErrorResponse{Error: idParam})
 		return
 	}
-	RoleID, err := service.GetRoleIDFromToken(c)
+	err = service.UnblockUser(uint(id))
 	if err != nil {
 		handleError(c, err)
 		return
 	}
-	err = service.UnblockUser(uint(id), RoleID)
+	logger.Info.Printf("[controllers.UnblockUserController] Client IP: %s - Successfully unblocked user with ID %d.\n", ip, id)
+	c.JSON(http.StatusOK, NewDefaultResponse("User unblocked successfully."))
+}
+
+// RevokeUserSessions godoc
+// @Summary      Revoke user sessions
+// @Description  Revoke every refresh token of a user so they have to sign in again
+// @Tags         Admin
+// @Accept       json
+// @Produce      json
+// @Param        id  path    integer  true  "User ID"  example(1)
+// @Success      200  {object}  DefaultResponse  "User sessions revoked successfully"
+// @Failure      400  {object}  ErrorResponse    "Invalid ID"
+// @Failure 	 403  {object}  ErrorResponse 	 "Access Denied"
+// @Failure      500  {object}  ErrorResponse    "Internal server error"
+// @Security     ApiKeyAuth
+// @Router       /users/sessions/{id} [delete]
+func RevokeUserSessions(c *gin.Context) {
+	ip := c.ClientIP()
+	idParam := c.Param("id")
+	logger.Info.Printf("[controllers.RevokeUserSessions] Client IP: %s - Request to revoke sessions of user with ID %s.\n", ip, idParam)
+	id, err := strconv.ParseUint(idParam, 10, 32)
+	if err != nil || id == 0 {
+		logger.Error.Printf("[controllers.RevokeUserSessions] Client IP: %s - Invalid user ID: %s.\n", ip, idParam)
+		handleError(c, errs.ErrIDIsNotCorrect)
+		return
+	}
+	err = service.RevokeUserSessions(uint(id))
 	if err != nil {
 		handleError(c, err)
 		return
 	}
-	logger.Info.Printf("[controllers.UnblockUserController] Client IP: %s - Successfully unblocked user with ID %d.\n", ip, id)
-	c.JSON(http.StatusOK, NewDefaultResponse("User unblocked successfully."))
+	logger.Info.Printf("[controllers.RevokeUserSessions] Client IP: %s - Successfully revoked sessions of user with ID %d.\n", ip, id)
+	c.JSON(http.StatusOK, NewDefaultResponse("User sessions revoked successfully."))
 }
 
 // GetSpecialistActivityReportByUser godoc
@@ -8,22 +8,34 @@ import (
 	"github.com/gin-gonic/gin"
 	"net/http"
 	"strconv"
+	"strings"
 )
 
 // GetAllVacancies
 // @Summary Retrieve all vacancies with filters
 // @Tags Vacancies
-// @Description Get a list of all vacancies with optional filters such as search, salary range, location, category, and sort order.
+// @Description Get a list of all vacancies with optional filters such as search, salary range, location, category, skills, employment type, schedule, remote mode, experience, languages and sort order.
 // @ID get-all-vacancies
 // @Accept json
 // @Produce json
 // @Param search query string false "Search keyword for filtering vacancies"
-// @Param minSalary query integer false "Minimum salary for filtering vacancies"
-// @Param maxSalary query integer false "Maximum salary for filtering vacancies"
-// @Param location query string false "Location for filtering vacancies"
+// @Param min-salary query number false "Minimum monthly salary for filtering vacancies, in currency"
+// @Param max-salary query number false "Maximum monthly salary for filtering vacancies, in currency"
+// @Param currency query string false "Currency of min-salary and max-salary: TJS, USD or RUB. Vacancies in other currencies are converted with the configured exchange rates. Hidden salaries match only for their employer"
+// @Param location query string false "Location name in any language, vacancies in the places inside it match too"
+// @Param location-id query integer false "Location ID from the locations dictionary, vacancies in the places inside it match too"
 // @Param category query string false "Category for filtering vacancies"
-// @Param sort query string false "Sorting order for vacancies"
-// @Success 200 {array}    models.Vacancy "Successfully retrieved list of vacancies"
+// @Param skills query string false "Comma separated skills, names or aliases from the skills dictionary, all are required"
+// @Param employment-type query string false "Comma separated employment types: full_time, part_time, contract, temporary, internship"
+// @Param schedule query string false "Comma separated work schedules: full_day, shift, flexible, rotation"
+// @Param remote-mode query string false "Comma separated remote modes: onsite, remote, hybrid"
+// @Param experience query integer false "Years of experience of the candidate, keeps vacancies whose experience range includes them"
+// @Param languages query string false "Comma separated ISO 639-1 language codes, all are required"
+// @Param sort query string false "Comma separated sort fields: created_at, salary_min, salary_max, monthly_salary, title, rank (with search), prefix with - for descending. monthly_salary is the salary converted to a monthly amount in the base currency, asc/desc sort by it. Hidden salaries sort as if there was none"
+// @Param page query integer false "Page number, starting from 1"
+// @Param size query integer false "Page size, up to 100"
+// @Param cursor query string false "Cursor of the next page returned by the previous request"
+// @Success 200 {object}   PageResponse[models.Vacancy] "Successfully retrieved list of vacancies"
 // @Failure 400 {object}   ErrorResponse "Bad Request"
 // @Failure 403  {object}  ErrorResponse 	 "Access Denied"
 // @Failure 500 {object}   ErrorResponse "Internal Server Error"
@@ -42,35 +54,73 @@ func GetAllVacancies(c *gin.Context) {
 		handleError(c, err)
 		return
 	}
+	params, err := parsePageParams(c)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+	switch sort {
+	case "asc":
+		params.Sort = []models.SortParam{{Field: "monthly_salary"}}
+	case "desc":
+		params.Sort = []models.SortParam{{Field: "monthly_salary", Desc: true}}
+	}
 	logger.Info.Printf("[controllers.GetAllVacancies] Client IP: %s - Request to get vacancies with keyword: %s, minSalary: %s, maxSalary: %s, location: %s, category: %s, sort: %s\n", ip, search, minSalaryStr, maxSalaryStr, location, category, sort)
 
-	var minSalary, maxSalary int
+	var minSalary, maxSalary float64
 	if minSalaryStr != "" {
-		minSalary, err = strconv.Atoi(minSalaryStr)
-		if err != nil {
-			logger.Error.Printf("[controllers.GetAllVacancies] Error converting minSalary to int: %s", err.Error())
-			handleError(c, errs.ErrIDIsNotCorrect)
+		minSalary, err = strconv.ParseFloat(minSalaryStr, 64)
+		if err != nil || minSalary < 0 {
+			logger.Error.Printf("[controllers.GetAllVacancies] Error converting minSalary to number: %s", minSalaryStr)
+			handleError(c, errs.ErrIncorrectInput)
 			return
 		}
 	}
 
 	if maxSalaryStr != "" {
-		maxSalary, err = strconv.Atoi(maxSalaryStr)
-		if err != nil {
-			logger.Error.Printf("[controllers.GetAllVacancies] Error converting maxSalary to int: %s", err.Error())
+		maxSalary, err = strconv.ParseFloat(maxSalaryStr, 64)
+		if err != nil || maxSalary < 0 {
+			logger.Error.Printf("[controllers.GetAllVacancies] Error converting maxSalary to number: %s", maxSalaryStr)
 			handleError(c, errs.ErrIncorrectInput)
 			return
 		}
 	}
 
-	vacancies, err := service.GetAllVacancies(userID, search, minSalary, maxSalary, location, category, sort)
+	filter := models.VacancyFilter{
+		Search:          search,
+		MinSalary:       minSalary,
+		MaxSalary:       maxSalary,
+		SalaryCurrency:  strings.ToUpper(c.Query("currency")),
+		Location:        location,
+		Category:        category,
+		Skills:          parseSkillsQuery(c),
+		EmploymentTypes: parseListQuery(c, "employment-type"),
+		WorkSchedules:   parseListQuery(c, "schedule"),
+		RemoteModes:     parseListQuery(c, "remote-mode"),
+		Languages:       parseListQuery(c, "languages"),
+	}
+	if filter.LocationID, err = parseLocationIDQuery(c); err != nil {
+		handleError(c, err)
+		return
+	}
+	if experienceStr := c.Query("experience"); experienceStr != "" {
+		experience, err := strconv.ParseUint(experienceStr, 10, 32)
+		if err != nil {
+			logger.Error.Printf("[controllers.GetAllVacancies] Error converting experience to number: %s", experienceStr)
+			handleError(c, errs.ErrIncorrectInput)
+			return
+		}
+		experienceYears := uint(experience)
+		filter.ExperienceYears = &experienceYears
+	}
+	vacancies, info, err := service.GetAllVacancies(userID, filter, params)
 	if err != nil {
 		handleError(c, err)
 		return
 	}
 
-	logger.Info.Printf("[controllers.GetAllVacancies] Client IP: %s - Successfully retrieved vacancies with keyword: %s, minSalary: %d, maxSalary: %d, location: %s, category: %s, sort: %s\n", ip, search, minSalary, maxSalary, location, category, sort)
-	c.JSON(http.StatusOK, vacancies)
+	logger.Info.Printf("[controllers.GetAllVacancies] Client IP: %s - Successfully retrieved vacancies with keyword: %s, minSalary: %v, maxSalary: %v, location: %s, category: %s, sort: %s\n", ip, search, minSalary, maxSalary, location, category, sort)
+	c.JSON(http.StatusOK, NewPageResponse(vacancies, params, info))
 }
 
 // GetVacancyByID
@@ -162,7 +212,7 @@ func AddVacancy(c *gin.Context) {
 // UpdateVacancy
 // @Summary Update an existing vacancy
 // @Tags Vacancies
-// @Description Update an existing vacancy by its ID.
+// @Description Update an existing vacancy by its ID. The salary and the experience range are each replaced as a whole when any of their fields is sent, an omitted max_experience_years removes the upper limit. Available to the vacancy author, members of its company and admins.
 // @ID update-vacancy
 // @Accept json
 // @Produce json
@@ -212,7 +262,7 @@ func UpdateVacancy(c *gin.Context) {
 // DeleteVacancy
 // @Summary Delete a vacancy
 // @Tags Vacancies
-// @Description Soft delete a specific vacancy by its ID.
+// @Description Soft delete a specific vacancy by its ID. Available to the vacancy author, members of its company and admins.
 // @ID delete-vacancy
 // @Accept json
 // @Produce json
@@ -276,7 +326,13 @@ func GetVacancyReportByID(c *gin.Context) {
 		return
 	}
 
-	report, err := service.GetVacancyReportByID(uint(vacancyID))
+	userID, err := service.GetUserIDFromToken(c)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	report, err := service.GetVacancyReportByID(userID, uint(vacancyID))
 	if err != nil {
 		handleError(c, err)
 		return
@@ -317,13 +373,8 @@ func BlockVacancy(c *gin.Context) {
 		handleError(c, err)
 		return
 	}
-	RoleID, err := service.GetRoleIDFromToken(c)
-	if err != nil {
-		handleError(c, err)
-		return
-	}
 
-	err = service.BlockVacancy(uint(id), userID, RoleID)
+	err = service.BlockVacancy(userID, uint(id))
 	if err != nil {
 		handleError(c, err)
 		return
@@ -364,13 +415,8 @@ func UnblockVacancy(c *gin.Context) {
 		handleError(c, err)
 		return
 	}
-	RoleID, err := service.GetRoleIDFromToken(c)
-	if err != nil {
-		handleError(c, err)
-		return
-	}
 
-	err = service.UnblockVacancy(uint(id), userID, RoleID)
+	err = service.UnblockVacancy(userID, uint(id))
 	if err != nil {
 		handleError(c, err)
 		return
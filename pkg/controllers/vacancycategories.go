@@ -50,7 +50,12 @@ func GetCategoryByID(c *gin.Context) {
 // @Tags         Categories
 // @Accept       json
 // @Produce      json
-// @Success      200  {array}   models.VacancyCategory  "Success"
+// @Param        sort    query   string  false  "Comma separated sort fields: created_at, name, prefix with - for descending"
+// @Param        page    query   int     false  "Page number, starting from 1"
+// @Param        size    query   int     false  "Page size, up to 100"
+// @Param        cursor  query   string  false  "Cursor of the next page returned by the previous request"
+// @Success      200  {object}  PageResponse[models.VacancyCategory]  "Success"
+// @Failure      400  {object}  ErrorResponse  "Invalid request"
 // @Failure      403  {object}  ErrorResponse  "Access Denied"
 // @Failure      500  {object}  ErrorResponse  "Internal server error"
 // @Security     ApiKeyAuth
@@ -59,14 +64,20 @@ func GetAllCategories(c *gin.Context) {
 	ip := c.ClientIP()
 	logger.Info.Printf("[controllers.GetAllCategories] Client IP: %s - Client requested all categories\n", ip)
 
-	categories, err := service.GetAllCategories()
+	params, err := parsePageParams(c)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	categories, info, err := service.GetAllCategories(params)
 	if err != nil {
 		handleError(c, err)
 		return
 	}
 
 	logger.Info.Printf("[controllers.GetAllCategories] Client IP: %s - Successfully retrieved all categories\n", ip)
-	c.JSON(http.StatusOK, categories)
+	c.JSON(http.StatusOK, NewPageResponse(categories, params, info))
 }
 
 // CreateCategory godoc
@@ -89,13 +100,8 @@ func CreateCategory(c *gin.Context) {
 		handleError(c, errs.ErrShouldBindJson)
 		return
 	}
-	RoleID, err := service.GetRoleIDFromToken(c)
-	if err != nil {
-		handleError(c, err)
-		return
-	}
 
-	if err := service.AddCategory(category, RoleID); err != nil {
+	if err := service.AddCategory(category); err != nil {
 		handleError(c, err)
 		return
 	}
@@ -134,12 +140,8 @@ func UpdateCategory(c *gin.Context) {
 		handleError(c, errs.ErrShouldBindJson)
 		return
 	}
-	RoleID, err := service.GetRoleIDFromToken(c)
-	if err != nil {
-		handleError(c, err)
-	}
 
-	if err := service.UpdateCategory(category, RoleID); err != nil {
+	if err := service.UpdateCategory(category); err != nil {
 		handleError(c, err)
 		return
 	}
@@ -169,12 +171,8 @@ func DeleteCategory(c *gin.Context) {
 		handleError(c, errs.ErrIDIsNotCorrect)
 		return
 	}
-	RoleID, err := service.GetRoleIDFromToken(c)
-	if err != nil {
-		handleError(c, err)
-	}
 
-	if err := service.DeleteCategory(uint(id), RoleID); err != nil {
+	if err := service.DeleteCategory(uint(id)); err != nil {
 		handleError(c, err)
 		return
 	}
@@ -0,0 +1,179 @@
+package controllers
+
+import (
+	"TajikCareerHub/logger"
+	"TajikCareerHub/models"
+	"TajikCareerHub/pkg/service"
+	"TajikCareerHub/utils/errs"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"strconv"
+)
+
+// GetMyVacancies godoc
+// @Summary Get my vacancies
+// @Description Get the vacancies written by the current user or belonging to the user's companies, in any status including drafts.
+// @Tags Vacancies
+// @Accept json
+// @Produce json
+// @Param status query string false "Vacancy status: draft, published, paused, expired, closed or filled"
+// @Param sort query string false "Comma separated sort fields: created_at, salary_min, salary_max, title, prefix with - for descending"
+// @Param page query integer false "Page number, starting from 1"
+// @Param size query integer false "Page size, up to 100"
+// @Param cursor query string false "Cursor of the next page returned by the previous request"
+// @Success 200 {object} PageResponse[models.Vacancy]
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Router /me/vacancies [get]
+// @Security ApiKeyAuth
+func GetMyVacancies(c *gin.Context) {
+	ip := c.ClientIP()
+	logger.Info.Printf("[controllers.GetMyVacancies] Client IP: %s - Request to get own vacancies\n", ip)
+	userID, err := service.GetUserIDFromToken(c)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+	params, err := parsePageParams(c)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	vacancies, info, err := service.GetMyVacancies(userID, c.Query("status"), params)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+	logger.Info.Printf("[controllers.GetMyVacancies] Client IP: %s - Successfully retrieved vacancies of user %v\n", ip, userID)
+	c.JSON(http.StatusOK, NewPageResponse(vacancies, params, info))
+}
+
+// PublishVacancy godoc
+// @Summary Publish a vacancy
+// @Description Publish a draft, paused or expired vacancy so that it is listed and accepts applications. Without expires_at the vacancy expires after the configured lifetime, a paused vacancy keeps its expiry date.
+// @Tags Vacancies
+// @Accept json
+// @Produce json
+// @Param vacancyID path integer true "Vacancy ID"
+// @Param publish body models.SwagVacancyPublish false "Expiry date"
+// @Success 200 {object} DefaultResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /vacancies/{vacancyID}/publish [patch]
+// @Security ApiKeyAuth
+func PublishVacancy(c *gin.Context) {
+	ip := c.ClientIP()
+	idStr := c.Param("vacancyID")
+	logger.Info.Printf("[controllers.PublishVacancy] Client IP: %s - Request to publish vacancy %s\n", ip, idStr)
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		logger.Error.Printf("[controllers.PublishVacancy] Client IP: %s - Invalid vacancy ID %s: %v\n", ip, idStr, err)
+		handleError(c, errs.ErrIDIsNotCorrect)
+		return
+	}
+	var input models.SwagVacancyPublish
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&input); err != nil {
+			logger.Error.Printf("[controllers.PublishVacancy] Client IP: %s - Error parsing publish data: %v\n", ip, err)
+			handleError(c, errs.ErrShouldBindJson)
+			return
+		}
+	}
+	userID, err := service.GetUserIDFromToken(c)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	if err := service.PublishVacancy(userID, uint(id), input); err != nil {
+		handleError(c, err)
+		return
+	}
+	logger.Info.Printf("[controllers.PublishVacancy] Client IP: %s - Successfully published vacancy %v\n", ip, id)
+	c.JSON(http.StatusOK, NewDefaultResponse("Vacancy published successfully"))
+}
+
+// PauseVacancy godoc
+// @Summary Pause a vacancy
+// @Description Hide a published vacancy from the listings and stop accepting applications until it is published again.
+// @Tags Vacancies
+// @Accept json
+// @Produce json
+// @Param vacancyID path integer true "Vacancy ID"
+// @Success 200 {object} DefaultResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /vacancies/{vacancyID}/pause [patch]
+// @Security ApiKeyAuth
+func PauseVacancy(c *gin.Context) {
+	ip := c.ClientIP()
+	idStr := c.Param("vacancyID")
+	logger.Info.Printf("[controllers.PauseVacancy] Client IP: %s - Request to pause vacancy %s\n", ip, idStr)
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		logger.Error.Printf("[controllers.PauseVacancy] Client IP: %s - Invalid vacancy ID %s: %v\n", ip, idStr, err)
+		handleError(c, errs.ErrIDIsNotCorrect)
+		return
+	}
+	userID, err := service.GetUserIDFromToken(c)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	if err := service.PauseVacancy(userID, uint(id)); err != nil {
+		handleError(c, err)
+		return
+	}
+	logger.Info.Printf("[controllers.PauseVacancy] Client IP: %s - Successfully paused vacancy %v\n", ip, id)
+	c.JSON(http.StatusOK, NewDefaultResponse("Vacancy paused successfully"))
+}
+
+// CloseVacancy godoc
+// @Summary Close a vacancy
+// @Description Close a vacancy for good, as closed or, when the position was taken, as filled. Closed vacancies can't be published again.
+// @Tags Vacancies
+// @Accept json
+// @Produce json
+// @Param vacancyID path integer true "Vacancy ID"
+// @Param close body models.SwagVacancyClose false "Final status: closed (default) or filled"
+// @Success 200 {object} DefaultResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /vacancies/{vacancyID}/close [patch]
+// @Security ApiKeyAuth
+func CloseVacancy(c *gin.Context) {
+	ip := c.ClientIP()
+	idStr := c.Param("vacancyID")
+	logger.Info.Printf("[controllers.CloseVacancy] Client IP: %s - Request to close vacancy %s\n", ip, idStr)
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		logger.Error.Printf("[controllers.CloseVacancy] Client IP: %s - Invalid vacancy ID %s: %v\n", ip, idStr, err)
+		handleError(c, errs.ErrIDIsNotCorrect)
+		return
+	}
+	var input models.SwagVacancyClose
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&input); err != nil {
+			logger.Error.Printf("[controllers.CloseVacancy] Client IP: %s - Error parsing close data: %v\n", ip, err)
+			handleError(c, errs.ErrShouldBindJson)
+			return
+		}
+	}
+	userID, err := service.GetUserIDFromToken(c)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	if err := service.CloseVacancy(userID, uint(id), input.Status); err != nil {
+		handleError(c, err)
+		return
+	}
+	logger.Info.Printf("[controllers.CloseVacancy] Client IP: %s - Successfully closed vacancy %v\n", ip, id)
+	c.JSON(http.StatusOK, NewDefaultResponse("Vacancy closed successfully"))
+}
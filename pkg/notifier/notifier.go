@@ -0,0 +1,50 @@
+package notifier
+
+import (
+	"TajikCareerHub/models"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+const (
+	TypeSMTP   = "smtp"
+	TypeFile   = "file"
+	TypeStdout = "stdout"
+)
+
+// Message is a plain text message to one recipient.
+type Message struct {
+	ToName  string
+	To      string
+	Subject string
+	Body    string
+}
+
+// Notifier delivers messages to users.
+type Notifier interface {
+	Send(message Message) error
+}
+
+// New creates the notifier selected by params. Without a type messages are printed to stdout.
+func New(params models.NotifierParams) (Notifier, error) {
+	switch params.Type {
+	case TypeSMTP:
+		return NewSMTPNotifier(params, os.Getenv("SMTP_PASSWORD"))
+	case TypeFile:
+		if params.FilePath == "" {
+			return nil, fmt.Errorf("notifier file path is not set")
+		}
+		if err := os.MkdirAll(filepath.Dir(params.FilePath), 0755); err != nil {
+			return nil, fmt.Errorf("failed to create notifier directory: %v", err)
+		}
+		file, err := os.OpenFile(params.FilePath, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open notifier file: %v", err)
+		}
+		return NewWriterNotifier(file), nil
+	case "", TypeStdout:
+		return NewWriterNotifier(os.Stdout), nil
+	}
+	return nil, fmt.Errorf("unknown notifier type %q", params.Type)
+}
@@ -0,0 +1,73 @@
+package notifier
+
+import (
+	"TajikCareerHub/models"
+	"bytes"
+	"fmt"
+	"mime"
+	"mime/quotedprintable"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"time"
+)
+
+// SMTPNotifier sends messages as emails. smtp.SendMail switches to TLS with STARTTLS when the
+// server supports it.
+type SMTPNotifier struct {
+	addr string
+	from *mail.Address
+	auth smtp.Auth
+}
+
+// NewSMTPNotifier creates an SMTP notifier. The server is used without authentication when
+// no username is configured.
+func NewSMTPNotifier(params models.NotifierParams, password string) (*SMTPNotifier, error) {
+	if params.SMTPHost == "" || params.SMTPPort == "" {
+		return nil, fmt.Errorf("SMTP host and port are not set")
+	}
+	from, err := mail.ParseAddress(params.From)
+	if err != nil {
+		return nil, fmt.Errorf("invalid sender address %q: %v", params.From, err)
+	}
+	n := &SMTPNotifier{
+		addr: net.JoinHostPort(params.SMTPHost, params.SMTPPort),
+		from: from,
+	}
+	if params.SMTPUsername != "" {
+		n.auth = smtp.PlainAuth("", params.SMTPUsername, password, params.SMTPHost)
+	}
+	return n, nil
+}
+
+func (n *SMTPNotifier) Send(message Message) error {
+	to, err := mail.ParseAddress(message.To)
+	if err != nil {
+		return fmt.Errorf("invalid recipient address %q: %v", message.To, err)
+	}
+	to.Name = message.ToName
+
+	var body bytes.Buffer
+	w := quotedprintable.NewWriter(&body)
+	if _, err = w.Write([]byte(message.Body)); err != nil {
+		return err
+	}
+	if err = w.Close(); err != nil {
+		return err
+	}
+
+	var msg bytes.Buffer
+	fmt.Fprintf(&msg, "From: %s\r\n", n.from.String())
+	fmt.Fprintf(&msg, "To: %s\r\n", to.String())
+	fmt.Fprintf(&msg, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", message.Subject))
+	fmt.Fprintf(&msg, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	msg.WriteString("MIME-Version: 1.0\r\n")
+	msg.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	msg.WriteString("Content-Transfer-Encoding: quoted-printable\r\n\r\n")
+	msg.Write(body.Bytes())
+
+	if err = smtp.SendMail(n.addr, n.auth, n.from.Address, []string{to.Address}, msg.Bytes()); err != nil {
+		return fmt.Errorf("failed to send email to %s: %v", to.Address, err)
+	}
+	return nil
+}
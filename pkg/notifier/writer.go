@@ -0,0 +1,27 @@
+package notifier
+
+import (
+	"fmt"
+	"io"
+	"sync"
+	"time"
+)
+
+// WriterNotifier writes messages to a writer such as a file or stdout. It is meant for
+// development and tests, where sending real emails is not wanted.
+type WriterNotifier struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+func NewWriterNotifier(w io.Writer) *WriterNotifier {
+	return &WriterNotifier{w: w}
+}
+
+func (n *WriterNotifier) Send(message Message) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	_, err := fmt.Fprintf(n.w, "----- %s\nTo: %s <%s>\nSubject: %s\n\n%s\n\n",
+		time.Now().Format(time.RFC3339), message.ToName, message.To, message.Subject, message.Body)
+	return err
+}
@@ -0,0 +1,60 @@
+package repository
+
+import (
+	"TajikCareerHub/db"
+	"TajikCareerHub/logger"
+	"TajikCareerHub/models"
+	"gorm.io/gorm"
+)
+
+func GetApplicationNotes(applicationID uint) (notes []models.ApplicationNote, err error) {
+	err = db.GetDBConn().
+		Preload("Author", func(db *gorm.DB) *gorm.DB {
+			return db.Select("id", "full_name", "email")
+		}).
+		Where("application_id = ?", applicationID).
+		Order("created_at ASC, id ASC").
+		Find(&notes).Error
+	if err != nil {
+		logger.Error.Printf("[repository.GetApplicationNotes] Error getting notes of application with ID %v: %v\n", applicationID, err)
+		return nil, TranslateError(err)
+	}
+	return notes, nil
+}
+
+func GetApplicationNoteByID(id uint) (note models.ApplicationNote, err error) {
+	err = db.GetDBConn().Where("id = ?", id).First(&note).Error
+	if err != nil {
+		logger.Error.Printf("[repository.GetApplicationNoteByID] Error getting application note with ID %v: %v\n", id, err)
+		return models.ApplicationNote{}, TranslateError(err)
+	}
+	return note, nil
+}
+
+func AddApplicationNote(note models.ApplicationNote) (err error) {
+	if err = db.GetDBConn().Create(&note).Error; err != nil {
+		logger.Error.Printf("[repository.AddApplicationNote] Failed to add note to application with ID %v: %v\n", note.ApplicationID, err)
+		return TranslateError(err)
+	}
+	return nil
+}
+
+func DeleteApplicationNote(id uint) (err error) {
+	if err = db.GetDBConn().Delete(&models.ApplicationNote{}, id).Error; err != nil {
+		logger.Error.Printf("[repository.DeleteApplicationNote] Failed to delete application note with ID %v: %v\n", id, err)
+		return TranslateError(err)
+	}
+	return nil
+}
+
+func UpdateApplicationRating(applicationID uint, rating uint) (err error) {
+	err = db.GetDBConn().
+		Model(&models.Application{}).
+		Where("id = ? AND deleted_at = false", applicationID).
+		Update("rating", rating).Error
+	if err != nil {
+		logger.Error.Printf("[repository.UpdateApplicationRating] Failed to rate application with ID %v: %v\n", applicationID, err)
+		return TranslateError(err)
+	}
+	return nil
+}
@@ -4,27 +4,138 @@ import (
 	"TajikCareerHub/db"
 	"TajikCareerHub/logger"
 	"TajikCareerHub/models"
+	"TajikCareerHub/utils/errs"
+	"errors"
+	"gorm.io/gorm"
 )
 
-func GetAllApplications() (applications []models.Application, err error) {
-	err = db.GetDBConn().
-		Preload("User").
-		Preload("Vacancy").
-		Preload("Resume").
-		Where("deleted_at = false").
-		Find(&applications).Error
+var applicationSortColumns = map[string]string{
+	"id":         "applications.id",
+	"created_at": "applications.created_at",
+	"status_id":  "applications.status_id",
+}
+
+func GetAllApplications(params models.PageParams) (applications []models.Application, info models.PageInfo, err error) {
+	query := db.GetDBConn().
+		Model(&models.Application{}).
+		Joins("JOIN users ON users.id = applications.user_id").
+		Joins("JOIN vacancies ON vacancies.id = applications.vacancy_id").
+		Joins("JOIN resumes ON resumes.id = applications.resume_id").
+		Where("applications.deleted_at = false").
+		Where("users.deleted_at = false AND users.is_blocked = false").
+		Where("vacancies.deleted_at = false AND vacancies.is_blocked = false").
+		Where("resumes.deleted_at = false AND resumes.is_blocked = false")
+	if len(params.Sort) == 0 {
+		params.Sort = []models.SortParam{{Field: "created_at", Desc: true}}
+	}
+
+	info, err = paginate(query, params, applicationSortColumns, &applications, func(db *gorm.DB) *gorm.DB {
+		return db.Select("applications.*").
+			Preload("User", func(db *gorm.DB) *gorm.DB {
+				return db.Select("id", "full_name", "email")
+			}).
+			Preload("Vacancy").
+			Preload("Resume").
+			Preload("Status")
+	})
 	if err != nil {
 		logger.Error.Printf("[repository.GetAllApplications] Error fetching applications: %v", err)
-		return nil, TranslateError(err)
+		return nil, info, err
+	}
+	return applications, info, nil
+}
+
+// GetReceivedApplications returns the applications sent to one vacancy or to all vacancies
+// of an employer: the ones they created and the ones of companies they are a member of.
+// Applications with deleted or blocked resumes or applicants are excluded.
+func GetReceivedApplications(filter models.ApplicationFilter, params models.PageParams) (applications []models.Application, info models.PageInfo, err error) {
+	query := db.GetDBConn().
+		Model(&models.Application{}).
+		Joins("JOIN vacancies ON vacancies.id = applications.vacancy_id").
+		Joins("JOIN resumes ON resumes.id = applications.resume_id").
+		Joins("JOIN users ON users.id = applications.user_id").
+		Where("applications.deleted_at = false AND vacancies.deleted_at = false").
+		Where("resumes.deleted_at = false AND resumes.is_blocked = false").
+		Where("users.deleted_at = false AND users.is_blocked = false")
+
+	if filter.VacancyID != 0 {
+		query = query.Where("applications.vacancy_id = ?", filter.VacancyID)
+	}
+	if filter.EmployerID != 0 {
+		query = query.Where("(vacancies.user_id = ? OR vacancies.company_id IN (SELECT company_id FROM company_members WHERE user_id = ?))", filter.EmployerID, filter.EmployerID)
+	}
+	if filter.Status != "" {
+		query = query.Joins("JOIN application_statuses ON application_statuses.id = applications.status_id").
+			Where("application_statuses.name = ?", filter.Status)
+	}
+	if filter.CreatedFrom != nil {
+		query = query.Where("applications.created_at >= ?", *filter.CreatedFrom)
+	}
+	if filter.CreatedTo != nil {
+		query = query.Where("applications.created_at < ?", *filter.CreatedTo)
+	}
+	if filter.MinExperienceYears > 0 {
+		query = query.Where("resumes.experience_years >= ?", filter.MinExperienceYears)
+	}
+	if len(params.Sort) == 0 {
+		params.Sort = []models.SortParam{{Field: "created_at", Desc: true}}
+	}
+
+	info, err = paginate(query, params, applicationSortColumns, &applications, func(db *gorm.DB) *gorm.DB {
+		return db.Select("applications.*").
+			Preload("User", func(db *gorm.DB) *gorm.DB {
+				return db.Select("id", "full_name", "email")
+			}).
+			Preload("Vacancy").
+			Preload("Resume.VacancyCategory").
+			Preload("Status").
+			Preload("Notes", func(db *gorm.DB) *gorm.DB {
+				return db.Order("created_at ASC, id ASC")
+			}).
+			Preload("Notes.Author", func(db *gorm.DB) *gorm.DB {
+				return db.Select("id", "full_name", "email")
+			})
+	})
+	if err != nil {
+		logger.Error.Printf("[repository.GetReceivedApplications] Error fetching received applications: %v\n", err)
+		return nil, info, err
 	}
-	return applications, nil
+	return applications, info, nil
+}
+
+func GetApplicationsByUser(userID uint, status string, params models.PageParams) (applications []models.Application, info models.PageInfo, err error) {
+	query := db.GetDBConn().
+		Model(&models.Application{}).
+		Where("applications.user_id = ? AND applications.deleted_at = false", userID)
+	if status != "" {
+		query = query.Joins("JOIN application_statuses ON application_statuses.id = applications.status_id").
+			Where("application_statuses.name = ?", status)
+	}
+	if len(params.Sort) == 0 {
+		params.Sort = []models.SortParam{{Field: "created_at", Desc: true}}
+	}
+
+	info, err = paginate(query, params, applicationSortColumns, &applications, func(db *gorm.DB) *gorm.DB {
+		return db.Select("applications.*").
+			Preload("Vacancy.Company").
+			Preload("Resume").
+			Preload("Status")
+	})
+	if err != nil {
+		logger.Error.Printf("[repository.GetApplicationsByUser] Error fetching applications of user with ID %v: %v\n", userID, err)
+		return nil, info, err
+	}
+	return applications, info, nil
 }
 
 func GetApplicationByID(id uint) (application models.Application, err error) {
 	err = db.GetDBConn().
-		Preload("User").
+		Preload("User", func(db *gorm.DB) *gorm.DB {
+			return db.Select("id", "full_name", "email")
+		}).
 		Preload("Vacancy").
 		Preload("Resume").
+		Preload("Status").
 		Where("id = ? AND deleted_at = false", id).
 		First(&application).Error
 	if err != nil {
@@ -34,10 +145,37 @@ func GetApplicationByID(id uint) (application models.Application, err error) {
 	return application, nil
 }
 
+func HasActiveApplication(userID uint, vacancyID uint) (bool, error) {
+	var count int64
+	err := db.GetDBConn().
+		Model(&models.Application{}).
+		Where("user_id = ? AND vacancy_id = ? AND deleted_at = false", userID, vacancyID).
+		Count(&count).Error
+	if err != nil {
+		logger.Error.Printf("[repository.HasActiveApplication] Error checking application of user %v to vacancy %v: %v\n", userID, vacancyID, err)
+		return false, TranslateError(err)
+	}
+	return count > 0, nil
+}
+
 func AddApplication(application models.Application) (err error) {
-	if err := db.GetDBConn().Create(&application).Error; err != nil {
+	err = db.GetDBConn().Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&application).Error; err != nil {
+			return err
+		}
+		return tx.Create(&models.ApplicationStatusHistory{
+			ApplicationID: application.ID,
+			ToStatusID:    application.StatusID,
+			ChangedByID:   application.UserID,
+		}).Error
+	})
+	if err != nil {
 		logger.Error.Printf("[repository.AddApplication]: Failed to add application, error: %v\n", err)
-		return TranslateError(err)
+		err = TranslateError(err)
+		if errors.Is(err, errs.ErrUniquenessViolation) {
+			return errs.ErrAlreadyApplied
+		}
+		return err
 	}
 	return nil
 }
@@ -67,12 +205,40 @@ func DeleteApplication(id uint) (err error) {
 	return nil
 }
 
-func UpdateApplicationStatus(applicationID uint, statusID uint) (err error) {
-	err = db.GetDBConn().Model(&models.Application{}).
-		Where("id = ? AND deleted_at = false", applicationID).
-		Update("status_id", statusID).Error
+// UpdateApplicationStatus moves the application from fromStatusID to toStatusID and records
+// the change in its history. It fails with ErrInvalidStatusTransition if the status was
+// changed concurrently.
+func UpdateApplicationStatus(applicationID uint, fromStatusID uint, toStatusID uint, changedByID uint) (err error) {
+	err = db.GetDBConn().Transaction(func(tx *gorm.DB) error {
+		return updateApplicationStatus(tx, models.ApplicationStatusHistory{
+			ApplicationID: applicationID,
+			FromStatusID:  &fromStatusID,
+			ToStatusID:    toStatusID,
+			ChangedByID:   changedByID,
+		})
+	})
 	if err != nil {
+		if errors.Is(err, errs.ErrInvalidStatusTransition) {
+			logger.Warning.Printf("[repository.UpdateApplicationStatus] Status of application with ID %v is no longer %v\n", applicationID, fromStatusID)
+			return err
+		}
+		logger.Error.Printf("[repository.UpdateApplicationStatus] Failed to update status of application with ID %v: %v\n", applicationID, err)
 		return TranslateError(err)
 	}
 	return nil
 }
+
+// updateApplicationStatus applies the status change described by the history entry within
+// tx and records it.
+func updateApplicationStatus(tx *gorm.DB, change models.ApplicationStatusHistory) error {
+	result := tx.Model(&models.Application{}).
+		Where("id = ? AND status_id = ? AND deleted_at = false", change.ApplicationID, *change.FromStatusID).
+		Update("status_id", change.ToStatusID)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return errs.ErrInvalidStatusTransition
+	}
+	return tx.Create(&change).Error
+}
@@ -0,0 +1,43 @@
+package repository
+
+import (
+	"TajikCareerHub/db"
+	"TajikCareerHub/logger"
+	"TajikCareerHub/models"
+	"gorm.io/gorm"
+)
+
+func GetApplicationStatusByID(id uint) (status models.ApplicationStatus, err error) {
+	err = db.GetDBConn().Where("id = ?", id).First(&status).Error
+	if err != nil {
+		logger.Error.Printf("[repository.GetApplicationStatusByID] Error getting application status with ID %v: %v\n", id, err)
+		return models.ApplicationStatus{}, TranslateError(err)
+	}
+	return status, nil
+}
+
+func GetApplicationStatusByName(name string) (status models.ApplicationStatus, err error) {
+	err = db.GetDBConn().Where("name = ?", name).First(&status).Error
+	if err != nil {
+		logger.Error.Printf("[repository.GetApplicationStatusByName] Error getting application status %s: %v\n", name, err)
+		return models.ApplicationStatus{}, TranslateError(err)
+	}
+	return status, nil
+}
+
+func GetApplicationStatusHistory(applicationID uint) (history []models.ApplicationStatusHistory, err error) {
+	err = db.GetDBConn().
+		Preload("FromStatus").
+		Preload("ToStatus").
+		Preload("ChangedBy", func(db *gorm.DB) *gorm.DB {
+			return db.Select("id", "full_name", "email")
+		}).
+		Where("application_id = ?", applicationID).
+		Order("created_at ASC, id ASC").
+		Find(&history).Error
+	if err != nil {
+		logger.Error.Printf("[repository.GetApplicationStatusHistory] Error getting status history of application with ID %v: %v\n", applicationID, err)
+		return nil, TranslateError(err)
+	}
+	return history, nil
+}
@@ -0,0 +1,135 @@
+package repository
+
+import (
+	"TajikCareerHub/db"
+	"TajikCareerHub/logger"
+	"TajikCareerHub/models"
+	"TajikCareerHub/utils/errs"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var bookmarkSortColumns = map[string]string{
+	"id":         "id",
+	"created_at": "created_at",
+	"updated_at": "updated_at",
+	"title":      "title",
+}
+
+// bookmarkSortColumnsFor qualifies the bookmark sort columns with the table name.
+func bookmarkSortColumnsFor(table string) map[string]string {
+	columns := make(map[string]string, len(bookmarkSortColumns))
+	for field, column := range bookmarkSortColumns {
+		columns[field] = table + "." + column
+	}
+	return columns
+}
+
+// bookmarkUpsert saves a bookmark or, when the user already has one for the item, replaces
+// its title, tags and note.
+func bookmarkUpsert(itemColumn string) clause.OnConflict {
+	return clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}, {Name: itemColumn}},
+		DoUpdates: clause.AssignmentColumns([]string{"title", "tags", "note", "updated_at"}),
+	}
+}
+
+// resumeAvailable tells whether a shortlisted resume can still be viewed.
+const resumeAvailable = `resumes.deleted_at = false AND resumes.is_blocked = false
+	AND users.deleted_at = false AND users.is_blocked = false`
+
+func GetVacancyFavorites(userID uint, tag string, params models.PageParams) (favorites []models.VacancyFavorite, info models.PageInfo, err error) {
+	query := db.GetDBConn().
+		Model(&models.VacancyFavorite{}).
+		Joins("JOIN vacancies ON vacancies.id = vacancy_favorites.vacancy_id").
+		Joins("JOIN companies ON companies.id = vacancies.company_id").
+		Joins("JOIN users ON users.id = vacancies.user_id").
+		Where("vacancy_favorites.user_id = ?", userID)
+	if tag != "" {
+		query = query.Where("vacancy_favorites.tags @> jsonb_build_array(?::text)", tag)
+	}
+	if len(params.Sort) == 0 {
+		params.Sort = []models.SortParam{{Field: "created_at", Desc: true}}
+	}
+
+	info, err = paginate(query, params, bookmarkSortColumnsFor("vacancy_favorites"), &favorites, func(db *gorm.DB) *gorm.DB {
+		// A favorite vacancy is available while it is listed.
+		return db.Select("vacancy_favorites.*, (?) AS available", vacancyListed()).
+			Preload("Vacancy.Company").
+			Preload("Vacancy.LocationDetails").
+			Preload("Vacancy.Skills")
+	})
+	if err != nil {
+		logger.Error.Printf("[repository.GetVacancyFavorites] Error fetching favorites of user with ID %v: %v\n", userID, err)
+		return nil, info, err
+	}
+	return favorites, info, nil
+}
+
+func SaveVacancyFavorite(favorite *models.VacancyFavorite) (err error) {
+	err = db.GetDBConn().Omit(clause.Associations).Clauses(bookmarkUpsert("vacancy_id")).Create(favorite).Error
+	if err != nil {
+		logger.Error.Printf("[repository.SaveVacancyFavorite] Failed to save favorite vacancy with ID %v: %v\n", favorite.VacancyID, err)
+		return TranslateError(err)
+	}
+	return nil
+}
+
+func RemoveVacancyFavorite(userID uint, vacancyID uint) (err error) {
+	result := db.GetDBConn().Where("user_id = ? AND vacancy_id = ?", userID, vacancyID).Delete(&models.VacancyFavorite{})
+	if result.Error != nil {
+		logger.Error.Printf("[repository.RemoveVacancyFavorite] Failed to remove favorite vacancy with ID %v: %v\n", vacancyID, result.Error)
+		return TranslateError(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return errs.ErrBookmarkNotFound
+	}
+	return nil
+}
+
+func GetResumeShortlist(userID uint, tag string, params models.PageParams) (items []models.ResumeShortlistItem, info models.PageInfo, err error) {
+	query := db.GetDBConn().
+		Model(&models.ResumeShortlistItem{}).
+		Joins("JOIN resumes ON resumes.id = resume_shortlist_items.resume_id").
+		Joins("JOIN users ON users.id = resumes.user_id").
+		Where("resume_shortlist_items.user_id = ?", userID)
+	if tag != "" {
+		query = query.Where("resume_shortlist_items.tags @> jsonb_build_array(?::text)", tag)
+	}
+	if len(params.Sort) == 0 {
+		params.Sort = []models.SortParam{{Field: "created_at", Desc: true}}
+	}
+
+	info, err = paginate(query, params, bookmarkSortColumnsFor("resume_shortlist_items"), &items, func(db *gorm.DB) *gorm.DB {
+		return db.Select("resume_shortlist_items.*, ("+resumeAvailable+") AS available").
+			Preload("Resume.VacancyCategory").
+			Preload("Resume.LocationDetails").
+			Preload("Resume.SkillTags", orderResumeSkills)
+	})
+	if err != nil {
+		logger.Error.Printf("[repository.GetResumeShortlist] Error fetching shortlist of user with ID %v: %v\n", userID, err)
+		return nil, info, err
+	}
+	return items, info, nil
+}
+
+func SaveResumeShortlistItem(item *models.ResumeShortlistItem) (err error) {
+	err = db.GetDBConn().Omit(clause.Associations).Clauses(bookmarkUpsert("resume_id")).Create(item).Error
+	if err != nil {
+		logger.Error.Printf("[repository.SaveResumeShortlistItem] Failed to shortlist resume with ID %v: %v\n", item.ResumeID, err)
+		return TranslateError(err)
+	}
+	return nil
+}
+
+func RemoveResumeShortlistItem(userID uint, resumeID uint) (err error) {
+	result := db.GetDBConn().Where("user_id = ? AND resume_id = ?", userID, resumeID).Delete(&models.ResumeShortlistItem{})
+	if result.Error != nil {
+		logger.Error.Printf("[repository.RemoveResumeShortlistItem] Failed to remove resume with ID %v from shortlist: %v\n", resumeID, result.Error)
+		return TranslateError(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return errs.ErrBookmarkNotFound
+	}
+	return nil
+}
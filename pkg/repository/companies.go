@@ -4,17 +4,30 @@ import (
 	"TajikCareerHub/db"
 	"TajikCareerHub/logger"
 	"TajikCareerHub/models"
+	"gorm.io/gorm"
+	"time"
 )
 
-func GetAllCompanies() (companies []models.Company, err error) {
-	err = db.GetDBConn().
-		Where("deleted_at = ?", false).
-		Find(&companies).Error
+var companySortColumns = map[string]string{
+	"id":         "id",
+	"created_at": "created_at",
+	"name":       "name",
+}
+
+func GetAllCompanies(params models.PageParams) (companies []models.Company, info models.PageInfo, err error) {
+	query := db.GetDBConn().
+		Model(&models.Company{}).
+		Where("deleted_at = ?", false)
+	if len(params.Sort) == 0 {
+		params.Sort = []models.SortParam{{Field: "name"}}
+	}
+
+	info, err = paginate(query, params, companySortColumns, &companies)
 	if err != nil {
 		logger.Error.Printf("[repository.GetAllCompanies]: Error retrieving all companies. Error: %v\n", err)
-		return nil, TranslateError(err)
+		return nil, info, err
 	}
-	return companies, nil
+	return companies, info, nil
 }
 
 func GetCompanyByID(id uint) (company models.Company, err error) {
@@ -28,8 +41,17 @@ func GetCompanyByID(id uint) (company models.Company, err error) {
 	return company, nil
 }
 
-func AddCompany(company models.Company) (err error) {
-	err = db.GetDBConn().Create(&company).Error
+func AddCompany(company models.Company, ownerID uint) (err error) {
+	err = db.GetDBConn().Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&company).Error; err != nil {
+			return err
+		}
+		return tx.Create(&models.CompanyMember{
+			CompanyID: company.ID,
+			UserID:    ownerID,
+			Role:      models.CompanyMemberRoleOwner,
+		}).Error
+	})
 	if err != nil {
 		logger.Error.Printf("[repository.AddCompany]: Failed to add company. Error: %v\n", err)
 		return TranslateError(err)
@@ -37,18 +59,70 @@ func AddCompany(company models.Company) (err error) {
 	return nil
 }
 
-func UpdateCompany(company models.Company) (err error) {
+// UpdateCompany saves the changed fields of the company. resetVerification sends the company
+// back to review, dropping the result of the previous one.
+func UpdateCompany(company models.Company, resetVerification bool) (err error) {
+	err = db.GetDBConn().Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(&models.Company{}).
+			Where("id = ? AND deleted_at = ?", company.ID, false).
+			Updates(company).Error
+		if err != nil || !resetVerification {
+			return err
+		}
+		return tx.Model(&models.Company{}).
+			Where("id = ?", company.ID).
+			Updates(map[string]interface{}{
+				"verification_status": models.CompanyStatusPending,
+				"reviewer_note":       "",
+				"reviewed_by_id":      nil,
+				"reviewed_at":         nil,
+			}).Error
+	})
+	if err != nil {
+		logger.Error.Printf("[repository.UpdateCompany]: Failed to update company with ID %v. Error: %v\n", company.ID, err)
+		return TranslateError(err)
+	}
+	return nil
+}
+
+func VerifyCompany(id uint, status string, note string, reviewerID uint) (err error) {
 	err = db.GetDBConn().
 		Model(&models.Company{}).
-		Where("id = ? AND deleted_at = ?", company.ID, false).
-		Updates(company).Error
+		Where("id = ? AND deleted_at = ?", id, false).
+		Updates(map[string]interface{}{
+			"verification_status": status,
+			"reviewer_note":       note,
+			"reviewed_by_id":      reviewerID,
+			"reviewed_at":         time.Now(),
+		}).Error
 	if err != nil {
-		logger.Error.Printf("[repository.UpdateCompany]: Failed to update company with ID %v. Error: %v\n", company.ID, err)
+		logger.Error.Printf("[repository.VerifyCompany]: Failed to review company with ID %v. Error: %v\n", id, err)
+		return TranslateError(err)
+	}
+	return nil
+}
+
+func updateBlockStatusCompany(id uint, isBlocked bool) (err error) {
+	err = db.GetDBConn().Model(&models.Company{}).Where("id = ?", id).Update("is_blocked", isBlocked).Error
+	if err != nil {
+		action := "block"
+		if !isBlocked {
+			action = "unblock"
+		}
+		logger.Error.Printf("[repository.updateBlockStatusCompany] Failed to %s company with ID %v: %v\n", action, id, err)
 		return TranslateError(err)
 	}
 	return nil
 }
 
+func BlockCompany(id uint) (err error) {
+	return updateBlockStatusCompany(id, true)
+}
+
+func UnblockCompany(id uint) (err error) {
+	return updateBlockStatusCompany(id, false)
+}
+
 func DeleteCompany(id uint) (err error) {
 	err = db.GetDBConn().
 		Model(&models.Company{}).
@@ -0,0 +1,147 @@
+package repository
+
+import (
+	"TajikCareerHub/db"
+	"TajikCareerHub/logger"
+	"TajikCareerHub/models"
+	"gorm.io/gorm"
+)
+
+func GetCompanyMember(companyID, userID uint) (member models.CompanyMember, err error) {
+	err = db.GetDBConn().
+		Where("company_id = ? AND user_id = ?", companyID, userID).
+		First(&member).Error
+	if err != nil {
+		logger.Error.Printf("[repository.GetCompanyMember]: Error retrieving member %v of company %v. Error: %v\n", userID, companyID, err)
+		return models.CompanyMember{}, TranslateError(err)
+	}
+	return member, nil
+}
+
+// GetMemberCompanyIDs returns the IDs of the companies the user is a member of.
+func GetMemberCompanyIDs(userID uint) (companyIDs []uint, err error) {
+	err = db.GetDBConn().
+		Model(&models.CompanyMember{}).
+		Where("user_id = ?", userID).
+		Pluck("company_id", &companyIDs).Error
+	if err != nil {
+		logger.Error.Printf("[repository.GetMemberCompanyIDs]: Error retrieving companies of member %v. Error: %v\n", userID, err)
+		return nil, TranslateError(err)
+	}
+	return companyIDs, nil
+}
+
+func GetCompanyMembers(companyID uint) (members []models.CompanyMember, err error) {
+	err = db.GetDBConn().
+		Preload("User", func(db *gorm.DB) *gorm.DB {
+			return db.Select("id", "full_name", "user_name", "email")
+		}).
+		Where("company_id = ?", companyID).
+		Order("id").
+		Find(&members).Error
+	if err != nil {
+		logger.Error.Printf("[repository.GetCompanyMembers]: Error retrieving members of company %v. Error: %v\n", companyID, err)
+		return nil, TranslateError(err)
+	}
+	return members, nil
+}
+
+func CountCompanyOwners(companyID uint) (count int64, err error) {
+	err = db.GetDBConn().
+		Model(&models.CompanyMember{}).
+		Where("company_id = ? AND role = ?", companyID, models.CompanyMemberRoleOwner).
+		Count(&count).Error
+	if err != nil {
+		logger.Error.Printf("[repository.CountCompanyOwners]: Error counting owners of company %v. Error: %v\n", companyID, err)
+		return 0, TranslateError(err)
+	}
+	return count, nil
+}
+
+func DeleteCompanyMember(companyID, userID uint) (err error) {
+	err = db.GetDBConn().
+		Where("company_id = ? AND user_id = ?", companyID, userID).
+		Delete(&models.CompanyMember{}).Error
+	if err != nil {
+		logger.Error.Printf("[repository.DeleteCompanyMember]: Failed to remove member %v from company %v. Error: %v\n", userID, companyID, err)
+		return TranslateError(err)
+	}
+	return nil
+}
+
+func CreateCompanyInvitation(invitation models.CompanyInvitation) (err error) {
+	if err = db.GetDBConn().Create(&invitation).Error; err != nil {
+		logger.Error.Printf("[repository.CreateCompanyInvitation]: Failed to invite user %v to company %v. Error: %v\n", invitation.UserID, invitation.CompanyID, err)
+		return TranslateError(err)
+	}
+	return nil
+}
+
+func HasPendingCompanyInvitation(companyID, userID uint) (bool, error) {
+	var count int64
+	err := db.GetDBConn().
+		Model(&models.CompanyInvitation{}).
+		Where("company_id = ? AND user_id = ? AND status = ? AND deleted_at = false", companyID, userID, models.InvitationStatusPending).
+		Count(&count).Error
+	if err != nil {
+		logger.Error.Printf("[repository.HasPendingCompanyInvitation]: Error checking invitations of user %v to company %v. Error: %v\n", userID, companyID, err)
+		return false, TranslateError(err)
+	}
+	return count > 0, nil
+}
+
+func GetCompanyInvitationByID(id uint) (invitation models.CompanyInvitation, err error) {
+	err = db.GetDBConn().
+		Where("id = ? AND deleted_at = false", id).
+		First(&invitation).Error
+	if err != nil {
+		logger.Error.Printf("[repository.GetCompanyInvitationByID]: Error retrieving invitation with ID %v. Error: %v\n", id, err)
+		return models.CompanyInvitation{}, TranslateError(err)
+	}
+	return invitation, nil
+}
+
+func GetPendingInvitationsByUser(userID uint) (invitations []models.CompanyInvitation, err error) {
+	err = db.GetDBConn().
+		Preload("Company").
+		Where("user_id = ? AND status = ? AND deleted_at = false", userID, models.InvitationStatusPending).
+		Find(&invitations).Error
+	if err != nil {
+		logger.Error.Printf("[repository.GetPendingInvitationsByUser]: Error retrieving invitations of user %v. Error: %v\n", userID, err)
+		return nil, TranslateError(err)
+	}
+	return invitations, nil
+}
+
+func AcceptCompanyInvitation(invitation models.CompanyInvitation) (err error) {
+	err = db.GetDBConn().Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(&models.CompanyInvitation{}).
+			Where("id = ?", invitation.ID).
+			Update("status", models.InvitationStatusAccepted).Error
+		if err != nil {
+			return err
+		}
+		return tx.Create(&models.CompanyMember{
+			CompanyID: invitation.CompanyID,
+			UserID:    invitation.UserID,
+			Role:      invitation.Role,
+		}).Error
+	})
+	if err != nil {
+		logger.Error.Printf("[repository.AcceptCompanyInvitation]: Failed to accept invitation with ID %v. Error: %v\n", invitation.ID, err)
+		return TranslateError(err)
+	}
+	return nil
+}
+
+func UpdateCompanyInvitationStatus(id uint, status string) (err error) {
+	err = db.GetDBConn().
+		Model(&models.CompanyInvitation{}).
+		Where("id = ?", id).
+		Update("status", status).Error
+	if err != nil {
+		logger.Error.Printf("[repository.UpdateCompanyInvitationStatus]: Failed to update invitation with ID %v. Error: %v\n", id, err)
+		return TranslateError(err)
+	}
+	return nil
+}
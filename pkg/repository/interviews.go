@@ -0,0 +1,154 @@
+package repository
+
+import (
+	"TajikCareerHub/db"
+	"TajikCareerHub/logger"
+	"TajikCareerHub/models"
+	"TajikCareerHub/utils/errs"
+	"errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+func GetInterviewByID(id uint) (interview models.Interview, err error) {
+	err = db.GetDBConn().
+		Preload("Application.Vacancy.Company").
+		Preload("Application.Status").
+		Preload("Interviewer", func(db *gorm.DB) *gorm.DB {
+			return db.Select("id", "full_name", "email")
+		}).
+		Where("id = ? AND deleted_at = false", id).
+		First(&interview).Error
+	if err != nil {
+		logger.Error.Printf("[repository.GetInterviewByID] Error getting interview with ID %v: %v\n", id, err)
+		return models.Interview{}, TranslateError(err)
+	}
+	return interview, nil
+}
+
+func GetInterviewsByApplication(applicationID uint) (interviews []models.Interview, err error) {
+	err = db.GetDBConn().
+		Preload("Interviewer", func(db *gorm.DB) *gorm.DB {
+			return db.Select("id", "full_name", "email")
+		}).
+		Where("application_id = ? AND deleted_at = false", applicationID).
+		Order("starts_at ASC").
+		Find(&interviews).Error
+	if err != nil {
+		logger.Error.Printf("[repository.GetInterviewsByApplication] Error getting interviews of application with ID %v: %v\n", applicationID, err)
+		return nil, TranslateError(err)
+	}
+	return interviews, nil
+}
+
+// lockInterviewCalendars locks the company and the interviewer until the end of the
+// transaction, so that concurrent bookings of either wait for it and see its interview.
+// NO KEY UPDATE doesn't block the inserts that reference the rows.
+func lockInterviewCalendars(tx *gorm.DB, companyID uint, interviewerID uint) error {
+	lock := clause.Locking{Strength: "NO KEY UPDATE"}
+	if err := tx.Clauses(lock).Select("id").Where("id = ?", companyID).Find(&models.Company{}).Error; err != nil {
+		return err
+	}
+	return tx.Clauses(lock).Select("id").Where("id = ?", interviewerID).Find(&models.User{}).Error
+}
+
+// checkInterviewConflict returns errs.ErrInterviewConflict when a pending interview of the
+// company's vacancies or of the interviewer overlaps the interview. The interview itself is
+// skipped, so that it can be rescheduled. The calendars must be locked by the transaction.
+func checkInterviewConflict(tx *gorm.DB, companyID uint, interview models.Interview) error {
+	var count int64
+	err := tx.Model(&models.Interview{}).
+		Joins("JOIN applications ON applications.id = interviews.application_id").
+		Joins("JOIN vacancies ON vacancies.id = applications.vacancy_id").
+		Where("(vacancies.company_id = ? OR interviews.interviewer_id = ?)", companyID, interview.InterviewerID).
+		Where("interviews.id <> ? AND interviews.deleted_at = false AND interviews.outcome = ?", interview.ID, models.InterviewOutcomePending).
+		Where("interviews.starts_at < ? AND interviews.ends_at > ?", interview.EndsAt, interview.StartsAt).
+		Count(&count).Error
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return errs.ErrInterviewConflict
+	}
+	return nil
+}
+
+// CreateInterview saves the interview unless it overlaps another pending interview of the
+// company or the interviewer and, when statusChange is set, moves the application to the
+// new status in the same transaction.
+func CreateInterview(interview *models.Interview, companyID uint, statusChange *models.ApplicationStatusHistory) (err error) {
+	err = db.GetDBConn().Transaction(func(tx *gorm.DB) error {
+		if err := lockInterviewCalendars(tx, companyID, interview.InterviewerID); err != nil {
+			return err
+		}
+		if err := checkInterviewConflict(tx, companyID, *interview); err != nil {
+			return err
+		}
+		if err := tx.Create(interview).Error; err != nil {
+			return err
+		}
+		if statusChange == nil {
+			return nil
+		}
+		return updateApplicationStatus(tx, *statusChange)
+	})
+	if err != nil {
+		if errors.Is(err, errs.ErrInterviewConflict) {
+			logger.Warning.Printf("[repository.CreateInterview] Interview between %v and %v overlaps another interview of company %v or interviewer %v\n", interview.StartsAt, interview.EndsAt, companyID, interview.InterviewerID)
+			return err
+		}
+		if errors.Is(err, errs.ErrInvalidStatusTransition) {
+			logger.Warning.Printf("[repository.CreateInterview] Status of application with ID %v changed while scheduling an interview\n", interview.ApplicationID)
+			return err
+		}
+		logger.Error.Printf("[repository.CreateInterview] Failed to create interview for application with ID %v: %v\n", interview.ApplicationID, err)
+		return TranslateError(err)
+	}
+	return nil
+}
+
+// RescheduleInterview saves the new time, place and interviewer of the interview unless it
+// overlaps another pending interview of the company or the interviewer.
+func RescheduleInterview(interview models.Interview, companyID uint) (err error) {
+	err = db.GetDBConn().Transaction(func(tx *gorm.DB) error {
+		if err := lockInterviewCalendars(tx, companyID, interview.InterviewerID); err != nil {
+			return err
+		}
+		if err := checkInterviewConflict(tx, companyID, interview); err != nil {
+			return err
+		}
+		return tx.Model(&models.Interview{}).
+			Where("id = ? AND deleted_at = false", interview.ID).
+			Updates(map[string]interface{}{
+				"starts_at":      interview.StartsAt,
+				"ends_at":        interview.EndsAt,
+				"timezone":       interview.Timezone,
+				"location":       interview.Location,
+				"meeting_link":   interview.MeetingLink,
+				"interviewer_id": interview.InterviewerID,
+				"sequence":       gorm.Expr("sequence + 1"),
+			}).Error
+	})
+	if err != nil {
+		if errors.Is(err, errs.ErrInterviewConflict) {
+			logger.Warning.Printf("[repository.RescheduleInterview] Interview with ID %v between %v and %v overlaps another interview of company %v or interviewer %v\n", interview.ID, interview.StartsAt, interview.EndsAt, companyID, interview.InterviewerID)
+			return err
+		}
+		logger.Error.Printf("[repository.RescheduleInterview] Failed to reschedule interview with ID %v: %v\n", interview.ID, err)
+		return TranslateError(err)
+	}
+	return nil
+}
+
+func UpdateInterview(id uint, fields map[string]interface{}) (err error) {
+	fields["sequence"] = gorm.Expr("sequence + 1")
+	err = db.GetDBConn().
+		Model(&models.Interview{}).
+		Where("id = ? AND deleted_at = false", id).
+		Updates(fields).Error
+	if err != nil {
+		logger.Error.Printf("[repository.UpdateInterview] Failed to update interview with ID %v: %v\n", id, err)
+		return TranslateError(err)
+	}
+	return nil
+}
@@ -0,0 +1,101 @@
+package repository
+
+import (
+	"TajikCareerHub/db"
+	"TajikCareerHub/logger"
+	"TajikCareerHub/models"
+	"gorm.io/gorm/clause"
+	"strings"
+)
+
+// locationNameMatches compares a location's names in every language with one value bound
+// three times.
+const locationNameMatches = "(lower(locations.name_tj) = lower(?) OR lower(locations.name_ru) = lower(?) OR lower(locations.name_en) = lower(?))"
+
+// locationSubtreeByID selects the IDs of the bound location and all places inside it.
+const locationSubtreeByID = `WITH RECURSIVE location_tree AS (
+		SELECT locations.id FROM locations WHERE locations.id = ?
+		UNION SELECT locations.id FROM locations JOIN location_tree ON locations.parent_id = location_tree.id
+	) SELECT id FROM location_tree`
+
+// locationSubtreeByName is locationSubtreeByID for the locations named by the value, which is
+// bound three times.
+const locationSubtreeByName = `WITH RECURSIVE location_tree AS (
+		SELECT locations.id FROM locations WHERE ` + locationNameMatches + `
+		UNION SELECT locations.id FROM locations JOIN location_tree ON locations.parent_id = location_tree.id
+	) SELECT id FROM location_tree`
+
+// locationFilter builds the condition on the location columns of table for the location
+// filter: the selected location and the places inside it, or the text of unlinked rows.
+func locationFilter(table string, locationID uint, location string) clause.Expr {
+	if locationID != 0 {
+		return clause.Expr{SQL: table + ".location_id IN (" + locationSubtreeByID + ")", Vars: []interface{}{locationID}}
+	}
+	return clause.Expr{
+		SQL:  "(" + table + ".location_id IN (" + locationSubtreeByName + ") OR (" + table + ".location_id IS NULL AND lower(" + table + ".location) = lower(?)))",
+		Vars: []interface{}{location, location, location, location},
+	}
+}
+
+// SearchLocations suggests locations for autocompletion. Locations whose name starts with
+// the search come first.
+// likeEscaper escapes the wildcards of a LIKE pattern and the escape character itself.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
+// escapeLike makes s match literally in a LIKE pattern with ESCAPE '\'.
+func escapeLike(s string) string {
+	return likeEscaper.Replace(s)
+}
+
+func SearchLocations(query models.LocationQuery) (locations []models.Location, err error) {
+	tx := db.GetDBConn().Model(&models.Location{}).Preload("Parent")
+	if query.Search != "" {
+		search := escapeLike(query.Search)
+		pattern := "%" + search + "%"
+		prefix := search + "%"
+		tx = tx.Where(`locations.name_tj ILIKE ? ESCAPE '\' OR locations.name_ru ILIKE ? ESCAPE '\' OR locations.name_en ILIKE ? ESCAPE '\'`, pattern, pattern, pattern).
+			Order(clause.OrderBy{Expression: clause.Expr{
+				SQL:  `CASE WHEN locations.name_tj ILIKE ? ESCAPE '\' OR locations.name_ru ILIKE ? ESCAPE '\' OR locations.name_en ILIKE ? ESCAPE '\' THEN 0 ELSE 1 END`,
+				Vars: []interface{}{prefix, prefix, prefix},
+			}})
+	}
+	if query.ParentID != nil {
+		tx = tx.Where("locations.parent_id = ?", *query.ParentID)
+	}
+	if query.Type != "" {
+		tx = tx.Where("locations.type = ?", query.Type)
+	}
+	err = tx.Order("locations.name_en, locations.id").Limit(query.Limit).Find(&locations).Error
+	if err != nil {
+		logger.Error.Printf("[repository.SearchLocations] Error searching locations: %v\n", err)
+		return nil, TranslateError(err)
+	}
+	return locations, nil
+}
+
+func GetLocationByID(id uint) (location models.Location, err error) {
+	err = db.GetDBConn().Preload("Parent").Where("id = ?", id).First(&location).Error
+	if err != nil {
+		logger.Error.Printf("[repository.GetLocationByID] Error getting location with ID %v: %v\n", id, err)
+		return models.Location{}, TranslateError(err)
+	}
+	return location, nil
+}
+
+// FindLocationByName finds the location named so in any language, regions before cities
+// and cities before districts when several share the name.
+func FindLocationByName(name string) (location models.Location, err error) {
+	err = db.GetDBConn().
+		Where(locationNameMatches, name, name, name).
+		Order(clause.OrderBy{Expression: clause.Expr{
+			SQL:  "CASE locations.type WHEN ? THEN 0 WHEN ? THEN 1 ELSE 2 END",
+			Vars: []interface{}{models.LocationTypeRegion, models.LocationTypeCity},
+		}}).
+		Order("locations.id").
+		First(&location).Error
+	if err != nil {
+		logger.Warning.Printf("[repository.FindLocationByName] Location %q not found: %v\n", name, err)
+		return models.Location{}, TranslateError(err)
+	}
+	return location, nil
+}
@@ -0,0 +1,22 @@
+package repository
+
+import "testing"
+
+func TestEscapeLike(t *testing.T) {
+	tests := []struct {
+		search string
+		want   string
+	}{
+		{"Dushanbe", "Dushanbe"},
+		{"Душанбе", "Душанбе"},
+		{"100%", `100\%`},
+		{"a_b", `a\_b`},
+		{`C:\Temp`, `C:\\Temp`},
+		{`\%_`, `\\\%\_`},
+	}
+	for _, tt := range tests {
+		if got := escapeLike(tt.search); got != tt.want {
+			t.Errorf("escapeLike(%q) = %q, want %q", tt.search, got, tt.want)
+		}
+	}
+}
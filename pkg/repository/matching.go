@@ -0,0 +1,81 @@
+package repository
+
+import (
+	"TajikCareerHub/db"
+	"TajikCareerHub/logger"
+	"TajikCareerHub/models"
+	"gorm.io/gorm"
+)
+
+// GetCandidateResumes preselects visible resumes worth scoring for the vacancy: resumes in
+// the vacancy's category or sharing a skill with it, the most recently updated first.
+func GetCandidateResumes(vacancy models.Vacancy, limit int) (resumes []models.Resume, err error) {
+	skillIDs := make([]uint, 0, len(vacancy.Skills))
+	for _, skill := range vacancy.Skills {
+		skillIDs = append(skillIDs, skill.ID)
+	}
+	query := db.GetDBConn().
+		Model(&models.Resume{}).
+		Select("resumes.*").
+		Joins("JOIN users ON users.id = resumes.user_id").
+		Where("resumes.deleted_at = false AND resumes.is_blocked = false").
+		Where("users.deleted_at = false AND users.is_blocked = false").
+		Where("resumes.user_id <> ?", vacancy.UserID)
+	if len(skillIDs) > 0 {
+		query = query.Where("resumes.vacancy_category_id = ? OR EXISTS (SELECT 1 FROM resume_skills WHERE resume_skills.resume_id = resumes.id AND resume_skills.skill_id IN ?)",
+			vacancy.VacancyCategoryID, skillIDs)
+	} else {
+		query = query.Where("resumes.vacancy_category_id = ?", vacancy.VacancyCategoryID)
+	}
+	err = query.
+		Preload("VacancyCategory").
+		Preload("LocationDetails.Parent").
+		Preload("SkillTags", orderResumeSkills).
+		Order("resumes.updated_at DESC").
+		Limit(limit).
+		Find(&resumes).Error
+	if err != nil {
+		logger.Error.Printf("[repository.GetCandidateResumes] Error fetching candidate resumes for vacancy %v: %v\n", vacancy.ID, err)
+		return nil, TranslateError(err)
+	}
+	return resumes, nil
+}
+
+// GetCandidateVacancies preselects open vacancies worth scoring for the resume: vacancies
+// in the resume's category or sharing a skill with it, the most recently updated first.
+func GetCandidateVacancies(resume models.Resume, limit int) (vacancies []models.Vacancy, err error) {
+	var skillIDs []uint
+	for _, tag := range resume.SkillTags {
+		if tag.SkillID != nil {
+			skillIDs = append(skillIDs, *tag.SkillID)
+		}
+	}
+	query := db.GetDBConn().
+		Model(&models.Vacancy{}).
+		Select("vacancies.*").
+		Scopes(listedVacancies).
+		Where("vacancies.user_id <> ?", resume.UserID)
+	if len(skillIDs) > 0 {
+		query = query.Where("vacancies.vacancy_category_id = ? OR EXISTS (SELECT 1 FROM vacancy_skills WHERE vacancy_skills.vacancy_id = vacancies.id AND vacancy_skills.skill_id IN ?)",
+			resume.VacancyCategoryID, skillIDs)
+	} else {
+		query = query.Where("vacancies.vacancy_category_id = ?", resume.VacancyCategoryID)
+	}
+	err = query.
+		Preload("Company").
+		Preload("VacancyCategory").
+		Preload("Skills").
+		Preload("Languages").
+		Preload("LocationDetails.Parent").
+		Preload("User", func(db *gorm.DB) *gorm.DB {
+			return db.Select("id", "full_name", "email")
+		}).
+		Order("vacancies.updated_at DESC").
+		Limit(limit).
+		Find(&vacancies).Error
+	if err != nil {
+		logger.Error.Printf("[repository.GetCandidateVacancies] Error fetching candidate vacancies for resume %v: %v\n", resume.ID, err)
+		return nil, TranslateError(err)
+	}
+	return vacancies, nil
+}
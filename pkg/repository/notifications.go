@@ -0,0 +1,49 @@
+package repository
+
+import (
+	"TajikCareerHub/db"
+	"TajikCareerHub/logger"
+	"TajikCareerHub/models"
+	"TajikCareerHub/utils/errs"
+	"gorm.io/gorm"
+	"time"
+)
+
+var notificationSortColumns = map[string]string{
+	"id":         "notifications.id",
+	"created_at": "notifications.created_at",
+}
+
+func GetNotificationsByUser(userID uint, unreadOnly bool, params models.PageParams) (notifications []models.Notification, info models.PageInfo, err error) {
+	query := db.GetDBConn().
+		Model(&models.Notification{}).
+		Where("notifications.user_id = ?", userID)
+	if unreadOnly {
+		query = query.Where("notifications.read_at IS NULL")
+	}
+	if len(params.Sort) == 0 {
+		params.Sort = []models.SortParam{{Field: "created_at", Desc: true}}
+	}
+
+	info, err = paginate(query, params, notificationSortColumns, &notifications)
+	if err != nil {
+		logger.Error.Printf("[repository.GetNotificationsByUser] Error fetching notifications of user with ID %v: %v\n", userID, err)
+		return nil, info, err
+	}
+	return notifications, info, nil
+}
+
+func MarkNotificationRead(userID uint, notificationID uint) (err error) {
+	result := db.GetDBConn().
+		Model(&models.Notification{}).
+		Where("id = ? AND user_id = ?", notificationID, userID).
+		Update("read_at", gorm.Expr("COALESCE(read_at, ?)", time.Now()))
+	if result.Error != nil {
+		logger.Error.Printf("[repository.MarkNotificationRead] Failed to mark notification with ID %v as read: %v\n", notificationID, result.Error)
+		return TranslateError(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return errs.ErrNotificationNotFound
+	}
+	return nil
+}
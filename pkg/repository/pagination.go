@@ -0,0 +1,199 @@
+package repository
+
+import (
+	"TajikCareerHub/logger"
+	"TajikCareerHub/models"
+	"TajikCareerHub/utils/errs"
+	"encoding/base64"
+	"encoding/json"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+	"reflect"
+	"strings"
+)
+
+type sortKey struct {
+	field string
+	expr  string
+	desc  bool
+}
+
+type pageCursor struct {
+	Sort   string            `json:"s"`
+	Values []json.RawMessage `json:"v"`
+}
+
+// paginate counts the rows matched by query and loads the requested page into dest.
+// columns whitelists the sortable fields: the key is the field's column name in the
+// model and the value is the SQL expression to order by. It must contain "id", which is
+// always used as the last sort key so that the order, and therefore the cursor, is stable.
+// scopes are applied only when loading the page (selects, preloads) and don't affect the total.
+func paginate(query *gorm.DB, params models.PageParams, columns map[string]string, dest interface{}, scopes ...func(*gorm.DB) *gorm.DB) (info models.PageInfo, err error) {
+	params = normalizePageParams(params)
+	sorts, err := resolveSort(params.Sort, columns)
+	if err != nil {
+		return info, err
+	}
+
+	stmt := &gorm.Statement{DB: query}
+	if err = stmt.Parse(dest); err != nil {
+		logger.Error.Printf("[repository.paginate] Error parsing model schema: %v\n", err)
+		return info, TranslateError(err)
+	}
+
+	if err = query.Session(&gorm.Session{}).Count(&info.Total).Error; err != nil {
+		logger.Error.Printf("[repository.paginate] Error counting rows: %v\n", err)
+		return info, TranslateError(err)
+	}
+
+	page := query.Session(&gorm.Session{}).Scopes(scopes...)
+	for _, s := range sorts {
+		if s.desc {
+			page = page.Order(s.expr + " DESC")
+		} else {
+			page = page.Order(s.expr + " ASC")
+		}
+	}
+	if params.Cursor != "" {
+		values, err := decodeCursor(params.Cursor, sorts, stmt.Schema)
+		if err != nil {
+			return info, err
+		}
+		page = page.Where(keysetCondition(sorts, values))
+	} else {
+		page = page.Offset((params.Page - 1) * params.Size)
+	}
+
+	if err = page.Limit(params.Size + 1).Find(dest).Error; err != nil {
+		logger.Error.Printf("[repository.paginate] Error fetching page: %v\n", err)
+		return info, TranslateError(err)
+	}
+
+	items := reflect.ValueOf(dest).Elem()
+	if items.Len() > params.Size {
+		items.Set(items.Slice(0, params.Size))
+		info.NextCursor, err = encodeCursor(items.Index(params.Size-1), sorts, stmt)
+		if err != nil {
+			logger.Error.Printf("[repository.paginate] Error encoding cursor: %v\n", err)
+			return info, err
+		}
+	}
+	return info, nil
+}
+
+func normalizePageParams(params models.PageParams) models.PageParams {
+	if params.Page < 1 {
+		params.Page = 1
+	}
+	if params.Size < 1 {
+		params.Size = models.DefaultPageSize
+	}
+	if params.Size > models.MaxPageSize {
+		params.Size = models.MaxPageSize
+	}
+	return params
+}
+
+func resolveSort(params []models.SortParam, columns map[string]string) (sorts []sortKey, err error) {
+	hasID := false
+	for _, p := range params {
+		expr, ok := columns[p.Field]
+		if !ok {
+			logger.Warning.Printf("[repository.resolveSort] Unsupported sort field %q\n", p.Field)
+			return nil, errs.ErrInvalidSortField
+		}
+		if p.Field == "id" {
+			hasID = true
+		}
+		sorts = append(sorts, sortKey{field: p.Field, expr: expr, desc: p.Desc})
+	}
+	if !hasID {
+		sorts = append(sorts, sortKey{field: "id", expr: columns["id"]})
+	}
+	return sorts, nil
+}
+
+func sortSignature(sorts []sortKey) string {
+	fields := make([]string, 0, len(sorts))
+	for _, s := range sorts {
+		if s.desc {
+			fields = append(fields, "-"+s.field)
+		} else {
+			fields = append(fields, s.field)
+		}
+	}
+	return strings.Join(fields, ",")
+}
+
+// keysetCondition selects the rows that come after values in the given order:
+// (a > x) OR (a = x AND b > y) OR ..., with < for descending keys.
+func keysetCondition(sorts []sortKey, values []interface{}) clause.Expr {
+	var sql strings.Builder
+	var vars []interface{}
+	for i, s := range sorts {
+		if i > 0 {
+			sql.WriteString(" OR ")
+		}
+		sql.WriteString("(")
+		for j := 0; j < i; j++ {
+			sql.WriteString(sorts[j].expr + " = ? AND ")
+			vars = append(vars, values[j])
+		}
+		if s.desc {
+			sql.WriteString(s.expr + " < ?)")
+		} else {
+			sql.WriteString(s.expr + " > ?)")
+		}
+		vars = append(vars, values[i])
+	}
+	return clause.Expr{SQL: "(" + sql.String() + ")", Vars: vars}
+}
+
+func encodeCursor(last reflect.Value, sorts []sortKey, stmt *gorm.Statement) (string, error) {
+	cursor := pageCursor{Sort: sortSignature(sorts)}
+	for _, s := range sorts {
+		field := stmt.Schema.LookUpField(s.field)
+		if field == nil {
+			return "", errs.ErrInvalidSortField
+		}
+		value, _ := field.ValueOf(stmt.Context, last)
+		raw, err := json.Marshal(value)
+		if err != nil {
+			return "", err
+		}
+		cursor.Values = append(cursor.Values, raw)
+	}
+	data, err := json.Marshal(cursor)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+func decodeCursor(encoded string, sorts []sortKey, sch *schema.Schema) (values []interface{}, err error) {
+	data, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, errs.ErrInvalidCursor
+	}
+	var cursor pageCursor
+	if err = json.Unmarshal(data, &cursor); err != nil {
+		return nil, errs.ErrInvalidCursor
+	}
+	if cursor.Sort != sortSignature(sorts) || len(cursor.Values) != len(sorts) {
+		logger.Warning.Printf("[repository.decodeCursor] Cursor was issued for sort %q, requested %q\n", cursor.Sort, sortSignature(sorts))
+		return nil, errs.ErrInvalidCursor
+	}
+	for i, s := range sorts {
+		field := sch.LookUpField(s.field)
+		if field == nil {
+			return nil, errs.ErrInvalidSortField
+		}
+		value := reflect.New(field.FieldType)
+		if err = json.Unmarshal(cursor.Values[i], value.Interface()); err != nil {
+			return nil, errs.ErrInvalidCursor
+		}
+		values = append(values, value.Elem().Interface())
+	}
+	return values, nil
+}
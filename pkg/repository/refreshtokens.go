@@ -0,0 +1,84 @@
+package repository
+
+import (
+	"TajikCareerHub/db"
+	"TajikCareerHub/logger"
+	"TajikCareerHub/models"
+	"TajikCareerHub/utils/errs"
+	"errors"
+	"gorm.io/gorm"
+	"time"
+)
+
+func CreateRefreshToken(token models.RefreshToken) (err error) {
+	if err = db.GetDBConn().Create(&token).Error; err != nil {
+		logger.Error.Printf("[repository.CreateRefreshToken] Failed to create refresh token for user ID %v: %v\n", token.UserID, err)
+		return TranslateError(err)
+	}
+	return nil
+}
+
+func GetRefreshTokenByHash(tokenHash string) (token models.RefreshToken, err error) {
+	err = db.GetDBConn().
+		Where("token_hash = ? AND deleted_at = false", tokenHash).
+		First(&token).Error
+	if err != nil {
+		logger.Error.Printf("[repository.GetRefreshTokenByHash] Error retrieving refresh token: %v\n", err)
+		return models.RefreshToken{}, TranslateError(err)
+	}
+	return token, nil
+}
+
+func RotateRefreshToken(oldTokenID uint, newToken models.RefreshToken) (err error) {
+	err = db.GetDBConn().Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&newToken).Error; err != nil {
+			return err
+		}
+		result := tx.Model(&models.RefreshToken{}).
+			Where("id = ? AND revoked_at IS NULL", oldTokenID).
+			Updates(map[string]interface{}{
+				"revoked_at":     time.Now(),
+				"replaced_by_id": newToken.ID,
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return errs.ErrRefreshTokenReused
+		}
+		return nil
+	})
+	if err != nil {
+		if errors.Is(err, errs.ErrRefreshTokenReused) {
+			logger.Warning.Printf("[repository.RotateRefreshToken] Refresh token with ID %v was already rotated\n", oldTokenID)
+			return err
+		}
+		logger.Error.Printf("[repository.RotateRefreshToken] Failed to rotate refresh token with ID %v: %v\n", oldTokenID, err)
+		return TranslateError(err)
+	}
+	return nil
+}
+
+func RevokeRefreshTokenFamily(familyID string) (err error) {
+	err = db.GetDBConn().
+		Model(&models.RefreshToken{}).
+		Where("family_id = ? AND revoked_at IS NULL", familyID).
+		Update("revoked_at", time.Now()).Error
+	if err != nil {
+		logger.Error.Printf("[repository.RevokeRefreshTokenFamily] Failed to revoke refresh token family %s: %v\n", familyID, err)
+		return TranslateError(err)
+	}
+	return nil
+}
+
+func RevokeUserRefreshTokens(userID uint) (err error) {
+	err = db.GetDBConn().
+		Model(&models.RefreshToken{}).
+		Where("user_id = ? AND revoked_at IS NULL", userID).
+		Update("revoked_at", time.Now()).Error
+	if err != nil {
+		logger.Error.Printf("[repository.RevokeUserRefreshTokens] Failed to revoke refresh tokens of user with ID %v: %v\n", userID, err)
+		return TranslateError(err)
+	}
+	return nil
+}
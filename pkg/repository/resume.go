@@ -6,41 +6,71 @@ import (
 	"TajikCareerHub/models"
 	"errors"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
-func GetAllResumes(search string, minExperienceYears int, location string, category string) (resumes []models.Resume, err error) {
+var resumeSortColumns = map[string]string{
+	"id":               "resumes.id",
+	"created_at":       "resumes.created_at",
+	"experience_years": "resumes.experience_years",
+	"title":            "resumes.title",
+}
+
+func GetAllResumes(filter models.ResumeFilter, params models.PageParams) (resumes []models.Resume, info models.PageInfo, err error) {
 	query := db.GetDBConn().
-		Preload("VacancyCategory").
 		Model(&models.Resume{}).
-		Where("resumes.deleted_at = false")
-	if search != "" {
-		query = query.Where("summary ILIKE ?", "%"+search+"%")
+		Joins("JOIN users ON users.id = resumes.user_id").
+		Where("resumes.deleted_at = false AND resumes.is_blocked = false").
+		Where("users.deleted_at = false AND users.is_blocked = false")
+	if filter.Search != "" {
+		query = query.Where("resumes.summary ILIKE ? OR EXISTS (SELECT 1 FROM resume_skills WHERE resume_skills.resume_id = resumes.id AND resume_skills.name = ?)",
+			"%"+filter.Search+"%", models.NormalizeSkillName(filter.Search))
 	}
 
-	if location != "" {
-		query = query.Where("location = ?", location)
+	if filter.LocationID != 0 || filter.Location != "" {
+		query = query.Where(locationFilter("resumes", filter.LocationID, filter.Location))
 	}
-	if category != "" {
+	if filter.Category != "" {
 		query = query.Joins("JOIN vacancy_categories ON vacancy_categories.id = resumes.vacancy_category_id").
-			Where("vacancy_categories.name = ?", category)
+			Where("vacancy_categories.name = ?", filter.Category)
 	}
 
-	if minExperienceYears > 0 {
-		query = query.Where("experience_years >= ?", minExperienceYears)
+	if filter.MinExperienceYears > 0 {
+		query = query.Where("resumes.experience_years >= ?", filter.MinExperienceYears)
+	}
+	for _, skill := range filter.Skills {
+		query = query.Where("EXISTS (SELECT 1 FROM resume_skills WHERE resume_skills.resume_id = resumes.id AND (resume_skills.name = ? OR resume_skills.skill_id IN ("+skillIDsByName+")))", skill, skill, skill)
+	}
+	if len(params.Sort) == 0 {
+		params.Sort = []models.SortParam{{Field: "created_at", Desc: true}}
 	}
 
-	err = query.Find(&resumes).Error
+	info, err = paginate(query, params, resumeSortColumns, &resumes, func(db *gorm.DB) *gorm.DB {
+		return db.Select("resumes.*").Preload("VacancyCategory").Preload("LocationDetails").Preload("SkillTags", orderResumeSkills)
+	})
 	if err != nil {
 		logger.Error.Printf("[repository.GetAllResumes] Error fetching resumes: %v", err)
-		return nil, TranslateError(err)
+		return nil, info, err
 	}
 
-	return resumes, nil
+	return resumes, info, nil
+}
+
+func orderResumeSkills(db *gorm.DB) *gorm.DB {
+	return db.Order("resume_skills.id")
 }
 
 func GetResumeByID(id uint) (resume models.Resume, err error) {
 	err = db.GetDBConn().
 		Preload("VacancyCategory").
+		Preload("LocationDetails.Parent").
+		Preload("Experience", func(db *gorm.DB) *gorm.DB {
+			return db.Order("resume_experiences.start_date DESC, resume_experiences.id")
+		}).
+		Preload("EducationHistory", func(db *gorm.DB) *gorm.DB {
+			return db.Order("resume_educations.start_year DESC, resume_educations.id")
+		}).
+		Preload("SkillTags", orderResumeSkills).
 		Where("id = ?", id).
 		Where("deleted_at = false").
 		First(&resume).Error
@@ -59,11 +89,54 @@ func AddResume(resume models.Resume) (err error) {
 	return nil
 }
 
+// UpdateResume saves the resume fields and replaces the structured sections that are not nil.
+// Sections left nil are kept as they are.
 func UpdateResume(resumeID uint, resume models.Resume) (err error) {
-	err = db.GetDBConn().
-		Model(&models.Resume{}).
-		Where("id = ? AND deleted_at = false", resumeID).
-		Updates(resume).Error
+	err = db.GetDBConn().Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(&models.Resume{}).
+			Where("id = ? AND deleted_at = false", resumeID).
+			Omit(clause.Associations).
+			Updates(resume).Error
+		if err != nil {
+			return err
+		}
+		// A location that isn't linked to the dictionary any more clears the link, which
+		// Updates skips.
+		err = tx.Model(&models.Resume{}).Where("id = ?", resumeID).Select("location", "location_id").Updates(resume).Error
+		if err != nil {
+			return err
+		}
+		// Derived flat fields may become empty when a section is cleared, which Updates skips.
+		derived := map[string]interface{}{}
+		if resume.Experience != nil {
+			derived["experience_years"] = resume.ExperienceYears
+		}
+		if resume.EducationHistory != nil {
+			derived["education"] = resume.Education
+		}
+		if resume.SkillTags != nil {
+			derived["skills"] = resume.Skills
+		}
+		if len(derived) > 0 {
+			if err := tx.Model(&models.Resume{}).Where("id = ?", resumeID).Updates(derived).Error; err != nil {
+				return err
+			}
+		}
+		if resume.Experience != nil {
+			if err := replaceResumeSection(tx, resumeID, &models.ResumeExperience{}, resume.Experience); err != nil {
+				return err
+			}
+		}
+		if resume.EducationHistory != nil {
+			if err := replaceResumeSection(tx, resumeID, &models.ResumeEducation{}, resume.EducationHistory); err != nil {
+				return err
+			}
+		}
+		if resume.SkillTags != nil {
+			return replaceResumeSection(tx, resumeID, &models.ResumeSkill{}, resume.SkillTags)
+		}
+		return nil
+	})
 	if err != nil {
 		logger.Error.Printf("[repository.UpdateResume]: Failed to update resume with ID %v. Error: %v\n", resumeID, err)
 		return TranslateError(err)
@@ -71,12 +144,24 @@ func UpdateResume(resumeID uint, resume models.Resume) (err error) {
 	return nil
 }
 
+func replaceResumeSection[T models.ResumeExperience | models.ResumeEducation | models.ResumeSkill](tx *gorm.DB, resumeID uint, model *T, entries []T) error {
+	if err := tx.Where("resume_id = ?", resumeID).Delete(model).Error; err != nil {
+		return err
+	}
+	if len(entries) == 0 {
+		return nil
+	}
+	return tx.Create(&entries).Error
+}
+
+// DeleteResume soft deletes the resume and removes it from the employers' shortlists.
 func DeleteResume(id uint) (err error) {
-	err = db.GetDBConn().
-		Model(&models.Resume{}).
-		Where("id = ?", id).
-		Update("deleted_at", true).
-		Error
+	err = db.GetDBConn().Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&models.Resume{}).Where("id = ?", id).Update("deleted_at", true).Error; err != nil {
+			return err
+		}
+		return tx.Where("resume_id = ?", id).Delete(&models.ResumeShortlistItem{}).Error
+	})
 	if err != nil {
 		logger.Error.Printf("[repository.DeleteResume] Failed to delete resume with ID %v: %v\n", id, err)
 		return TranslateError(err)
@@ -0,0 +1,39 @@
+package repository
+
+import (
+	"TajikCareerHub/db"
+	"TajikCareerHub/logger"
+	"TajikCareerHub/models"
+)
+
+func GetRoleByID(id uint) (role models.Role, err error) {
+	err = db.GetDBConn().Where("id = ?", id).First(&role).Error
+	if err != nil {
+		logger.Error.Printf("[repository.GetRoleByID] Error retrieving role with ID %v: %v\n", id, err)
+		return models.Role{}, TranslateError(err)
+	}
+	return role, nil
+}
+
+func GetRoleByName(name string) (role models.Role, err error) {
+	err = db.GetDBConn().Where("name = ?", name).First(&role).Error
+	if err != nil {
+		logger.Error.Printf("[repository.GetRoleByName] Error retrieving role %s: %v\n", name, err)
+		return models.Role{}, TranslateError(err)
+	}
+	return role, nil
+}
+
+func RoleHasPermission(roleID uint, permission string) (bool, error) {
+	var count int64
+	err := db.GetDBConn().
+		Table("role_permissions").
+		Joins("JOIN permissions ON permissions.id = role_permissions.permission_id").
+		Where("role_permissions.role_id = ? AND permissions.name = ?", roleID, permission).
+		Count(&count).Error
+	if err != nil {
+		logger.Error.Printf("[repository.RoleHasPermission] Error checking permission %s for role ID %v: %v\n", permission, roleID, err)
+		return false, TranslateError(err)
+	}
+	return count > 0, nil
+}
@@ -0,0 +1,131 @@
+package repository
+
+import (
+	"TajikCareerHub/db"
+	"TajikCareerHub/logger"
+	"TajikCareerHub/models"
+	"TajikCareerHub/utils/errs"
+	"gorm.io/gorm"
+	"time"
+)
+
+var savedSearchSortColumns = map[string]string{
+	"id":         "saved_searches.id",
+	"created_at": "saved_searches.created_at",
+	"name":       "saved_searches.name",
+}
+
+func GetSavedSearchesByUser(userID uint, params models.PageParams) (searches []models.SavedSearch, info models.PageInfo, err error) {
+	query := db.GetDBConn().
+		Model(&models.SavedSearch{}).
+		Where("saved_searches.user_id = ? AND saved_searches.deleted_at = false", userID)
+	if len(params.Sort) == 0 {
+		params.Sort = []models.SortParam{{Field: "created_at", Desc: true}}
+	}
+
+	info, err = paginate(query, params, savedSearchSortColumns, &searches)
+	if err != nil {
+		logger.Error.Printf("[repository.GetSavedSearchesByUser] Error fetching saved searches of user with ID %v: %v\n", userID, err)
+		return nil, info, err
+	}
+	return searches, info, nil
+}
+
+func GetSavedSearchByID(userID uint, searchID uint) (search models.SavedSearch, err error) {
+	err = db.GetDBConn().Where("id = ? AND user_id = ? AND deleted_at = false", searchID, userID).First(&search).Error
+	if err != nil {
+		logger.Error.Printf("[repository.GetSavedSearchByID] Error getting saved search with ID %v: %v\n", searchID, err)
+		return models.SavedSearch{}, TranslateError(err)
+	}
+	return search, nil
+}
+
+func CountSavedSearchesByUser(userID uint) (count int64, err error) {
+	if err = db.GetDBConn().Model(&models.SavedSearch{}).Where("user_id = ? AND deleted_at = false", userID).Count(&count).Error; err != nil {
+		logger.Error.Printf("[repository.CountSavedSearchesByUser] Error counting saved searches of user with ID %v: %v\n", userID, err)
+		return 0, TranslateError(err)
+	}
+	return count, nil
+}
+
+func AddSavedSearch(search *models.SavedSearch) (err error) {
+	if err = db.GetDBConn().Omit("User").Create(search).Error; err != nil {
+		logger.Error.Printf("[repository.AddSavedSearch] Failed to add saved search: %v\n", err)
+		return TranslateError(err)
+	}
+	return nil
+}
+
+// UpdateSavedSearch saves the editable fields of the search. The run time is left to the
+// scheduler unless it is set.
+func UpdateSavedSearch(search models.SavedSearch) (err error) {
+	columns := []interface{}{"filter", "frequency", "is_active"}
+	if !search.LastRunAt.IsZero() {
+		columns = append(columns, "last_run_at")
+	}
+	result := db.GetDBConn().
+		Model(&models.SavedSearch{}).
+		Where("id = ? AND user_id = ? AND deleted_at = false", search.ID, search.UserID).
+		Select("name", columns...).
+		Updates(&search)
+	if result.Error != nil {
+		logger.Error.Printf("[repository.UpdateSavedSearch] Failed to update saved search with ID %v: %v\n", search.ID, result.Error)
+		return TranslateError(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return errs.ErrSavedSearchNotFound
+	}
+	return nil
+}
+
+func DeleteSavedSearch(userID uint, searchID uint) (err error) {
+	result := db.GetDBConn().
+		Model(&models.SavedSearch{}).
+		Where("id = ? AND user_id = ? AND deleted_at = false", searchID, userID).
+		Update("deleted_at", true)
+	if result.Error != nil {
+		logger.Error.Printf("[repository.DeleteSavedSearch] Failed to delete saved search with ID %v: %v\n", searchID, result.Error)
+		return TranslateError(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return errs.ErrSavedSearchNotFound
+	}
+	return nil
+}
+
+// GetDueSavedSearches lists the active saved searches of active users whose digest period
+// has passed by now, with the user's name and email.
+func GetDueSavedSearches(now time.Time) (searches []models.SavedSearch, err error) {
+	err = db.GetDBConn().
+		Joins("JOIN users ON users.id = saved_searches.user_id").
+		Where("saved_searches.is_active = true AND saved_searches.deleted_at = false").
+		Where("users.deleted_at = false AND users.is_blocked = false").
+		Where("((saved_searches.frequency = ? AND saved_searches.last_run_at <= ?) OR (saved_searches.frequency <> ? AND saved_searches.last_run_at <= ?))",
+			models.SavedSearchFrequencyWeekly, now.Add(-models.SavedSearchPeriod(models.SavedSearchFrequencyWeekly)),
+			models.SavedSearchFrequencyWeekly, now.Add(-models.SavedSearchPeriod(models.SavedSearchFrequencyDaily))).
+		Preload("User", func(db *gorm.DB) *gorm.DB {
+			return db.Select("id", "full_name", "email")
+		}).
+		Order("saved_searches.last_run_at, saved_searches.id").
+		Find(&searches).Error
+	if err != nil {
+		logger.Error.Printf("[repository.GetDueSavedSearches] Error fetching due saved searches: %v\n", err)
+		return nil, TranslateError(err)
+	}
+	return searches, nil
+}
+
+// MoveSavedSearchRun sets the run time of the search from one time to another. It changes
+// nothing and returns false when the run time isn't from anymore, so two schedulers never
+// handle the same run.
+func MoveSavedSearchRun(searchID uint, from, to time.Time) (moved bool, err error) {
+	result := db.GetDBConn().
+		Model(&models.SavedSearch{}).
+		Where("id = ? AND last_run_at = ?", searchID, from).
+		UpdateColumn("last_run_at", to)
+	if result.Error != nil {
+		logger.Error.Printf("[repository.MoveSavedSearchRun] Failed to update run time of saved search with ID %v: %v\n", searchID, result.Error)
+		return false, TranslateError(result.Error)
+	}
+	return result.RowsAffected > 0, nil
+}
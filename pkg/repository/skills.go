@@ -0,0 +1,175 @@
+package repository
+
+import (
+	"TajikCareerHub/db"
+	"TajikCareerHub/logger"
+	"TajikCareerHub/models"
+	"gorm.io/gorm"
+)
+
+var skillSortColumns = map[string]string{
+	"id":         "skills.id",
+	"created_at": "skills.created_at",
+	"name":       "skills.name",
+}
+
+// skillIDsByName selects the dictionary skills whose name or alias equals the bound
+// normalized name. The name has to be bound twice.
+const skillIDsByName = `SELECT skills.id FROM skills WHERE skills.deleted_at = false AND (skills.name = ?
+	OR skills.id IN (SELECT skill_aliases.skill_id FROM skill_aliases WHERE skill_aliases.alias = ?))`
+
+func GetAllSkills(search string, params models.PageParams) (skills []models.Skill, info models.PageInfo, err error) {
+	query := db.GetDBConn().
+		Model(&models.Skill{}).
+		Where("skills.deleted_at = false")
+	if search != "" {
+		pattern := models.NormalizeSkillName(search) + "%"
+		query = query.Where(`skills.name LIKE ? OR skills.name_tj ILIKE ? OR skills.name_ru ILIKE ? OR skills.name_en ILIKE ?
+			OR skills.id IN (SELECT skill_aliases.skill_id FROM skill_aliases WHERE skill_aliases.alias LIKE ?)`,
+			pattern, "%"+search+"%", "%"+search+"%", "%"+search+"%", pattern)
+	}
+	if len(params.Sort) == 0 {
+		params.Sort = []models.SortParam{{Field: "name"}}
+	}
+
+	info, err = paginate(query, params, skillSortColumns, &skills, func(db *gorm.DB) *gorm.DB {
+		return db.Preload("Aliases")
+	})
+	if err != nil {
+		logger.Error.Printf("[repository.GetAllSkills]: Error retrieving skills. Error: %v\n", err)
+		return nil, info, err
+	}
+	return skills, info, nil
+}
+
+func GetSkillByID(id uint) (skill models.Skill, err error) {
+	err = db.GetDBConn().
+		Preload("Aliases").
+		Where("id = ? AND deleted_at = false", id).
+		First(&skill).Error
+	if err != nil {
+		logger.Error.Printf("[repository.GetSkillByID]: Error retrieving skill with ID %v. Error: %v\n", id, err)
+		return models.Skill{}, TranslateError(err)
+	}
+	return skill, nil
+}
+
+// GetSkillsByNames returns the dictionary skills whose name or one of the aliases is among names.
+// The names must be normalized.
+func GetSkillsByNames(names []string) (skills []models.Skill, err error) {
+	if len(names) == 0 {
+		return nil, nil
+	}
+	err = db.GetDBConn().
+		Preload("Aliases").
+		Where("deleted_at = false").
+		Where("name IN ? OR id IN (SELECT skill_id FROM skill_aliases WHERE alias IN ?)", names, names).
+		Find(&skills).Error
+	if err != nil {
+		logger.Error.Printf("[repository.GetSkillsByNames]: Error retrieving skills by names. Error: %v\n", err)
+		return nil, TranslateError(err)
+	}
+	return skills, nil
+}
+
+func GetSkillsByIDs(ids []uint) (skills []models.Skill, err error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	err = db.GetDBConn().
+		Where("id IN ? AND deleted_at = false", ids).
+		Find(&skills).Error
+	if err != nil {
+		logger.Error.Printf("[repository.GetSkillsByIDs]: Error retrieving skills by IDs. Error: %v\n", err)
+		return nil, TranslateError(err)
+	}
+	return skills, nil
+}
+
+// AddSkill creates the skill with its aliases and links the resume skill tags using any of its names.
+func AddSkill(skill models.Skill) (err error) {
+	err = db.GetDBConn().Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&skill).Error; err != nil {
+			return err
+		}
+		return linkResumeSkillTags(tx, skill)
+	})
+	if err != nil {
+		logger.Error.Printf("[repository.AddSkill]: Failed to add skill. Error: %v\n", err)
+		return TranslateError(err)
+	}
+	return nil
+}
+
+// UpdateSkill saves the skill's names and replaces its aliases.
+func UpdateSkill(skill models.Skill) (err error) {
+	err = db.GetDBConn().Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(&models.Skill{}).
+			Where("id = ? AND deleted_at = false", skill.ID).
+			Select("name", "name_tj", "name_ru", "name_en").
+			Updates(&skill).Error
+		if err != nil {
+			return err
+		}
+		if err := tx.Where("skill_id = ?", skill.ID).Delete(&models.SkillAlias{}).Error; err != nil {
+			return err
+		}
+		if len(skill.Aliases) > 0 {
+			if err := tx.Create(&skill.Aliases).Error; err != nil {
+				return err
+			}
+		}
+		if err := tx.Model(&models.ResumeSkill{}).Where("skill_id = ?", skill.ID).Update("skill_id", nil).Error; err != nil {
+			return err
+		}
+		return linkResumeSkillTags(tx, skill)
+	})
+	if err != nil {
+		logger.Error.Printf("[repository.UpdateSkill]: Failed to update skill with ID %v. Error: %v\n", skill.ID, err)
+		return TranslateError(err)
+	}
+	return nil
+}
+
+// DeleteSkill soft deletes the skill and unlinks it from vacancies and resumes.
+// The aliases are removed so that they can be used by another skill.
+func DeleteSkill(id uint) (err error) {
+	err = db.GetDBConn().Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&models.Skill{}).Where("id = ?", id).Update("deleted_at", true).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("skill_id = ?", id).Delete(&models.SkillAlias{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Exec("DELETE FROM vacancy_skills WHERE skill_id = ?", id).Error; err != nil {
+			return err
+		}
+		return tx.Model(&models.ResumeSkill{}).Where("skill_id = ?", id).Update("skill_id", nil).Error
+	})
+	if err != nil {
+		logger.Error.Printf("[repository.DeleteSkill]: Failed to soft delete skill with ID %v. Error: %v\n", id, err)
+		return TranslateError(err)
+	}
+	return nil
+}
+
+func linkResumeSkillTags(tx *gorm.DB, skill models.Skill) error {
+	return tx.Model(&models.ResumeSkill{}).
+		Where("skill_id IS NULL AND name IN ?", skill.SkillNames()).
+		Update("skill_id", skill.ID).Error
+}
+
+// replaceVacancySkills links the vacancy to exactly the given dictionary skills.
+func replaceVacancySkills(tx *gorm.DB, vacancyID uint, skillIDs []uint) error {
+	if err := tx.Exec("DELETE FROM vacancy_skills WHERE vacancy_id = ?", vacancyID).Error; err != nil {
+		return err
+	}
+	if len(skillIDs) == 0 {
+		return nil
+	}
+	links := make([]map[string]interface{}, 0, len(skillIDs))
+	for _, skillID := range skillIDs {
+		links = append(links, map[string]interface{}{"vacancy_id": vacancyID, "skill_id": skillID})
+	}
+	return tx.Table("vacancy_skills").Create(links).Error
+}
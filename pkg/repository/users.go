@@ -4,15 +4,30 @@ import (
 	"TajikCareerHub/db"
 	"TajikCareerHub/logger"
 	"TajikCareerHub/models"
+	"gorm.io/gorm"
 )
 
-func GetAllUsers() (users []models.User, err error) {
-	err = db.GetDBConn().Preload("Role").Omit("password").Where("deleted_at = false").Find(&users).Error
+var userSortColumns = map[string]string{
+	"id":         "id",
+	"created_at": "created_at",
+	"full_name":  "full_name",
+	"user_name":  "user_name",
+}
+
+func GetAllUsers(params models.PageParams) (users []models.User, info models.PageInfo, err error) {
+	query := db.GetDBConn().Model(&models.User{}).Where("deleted_at = false")
+	if len(params.Sort) == 0 {
+		params.Sort = []models.SortParam{{Field: "id"}}
+	}
+
+	info, err = paginate(query, params, userSortColumns, &users, func(db *gorm.DB) *gorm.DB {
+		return db.Preload("Role").Omit("password")
+	})
 	if err != nil {
 		logger.Error.Printf("[repository.GetAllUsers] error getting all users: %s\n", err.Error())
-		return nil, TranslateError(err)
+		return nil, info, err
 	}
-	return users, nil
+	return users, info, nil
 }
 
 func GetUserByID(id uint) (user models.User, err error) {
@@ -35,6 +50,17 @@ func GetUserByUsername(username string) (*models.User, error) {
 	return &user, nil
 }
 
+func GetUserByEmail(email string) (*models.User, error) {
+	var user models.User
+	err := db.GetDBConn().Preload("Role").Omit("password").Where("email = ? AND deleted_at = false", email).First(&user).Error
+
+	if err != nil {
+		logger.Error.Printf("[repository.GetUserByEmail] error getting user by email: %v\n", err)
+		return nil, TranslateError(err)
+	}
+	return &user, nil
+}
+
 //unused function
 //func UserExists(username, email string) (bool, bool, error) {
 //	var usernameExists, emailExists bool
@@ -63,10 +89,10 @@ func CreateUser(user models.User) (uint, error) {
 	return user.ID, nil
 }
 
-func GetUserByUsernameAndPassword(username, password string) (user models.User, err error) {
-	err = db.GetDBConn().Where("user_name = ? AND password = ? AND deleted_at = false", username, password).First(&user).Error
+func GetUserWithPasswordByUsername(username string) (user models.User, err error) {
+	err = db.GetDBConn().Where("user_name = ? AND deleted_at = false", username).First(&user).Error
 	if err != nil {
-		logger.Error.Printf("[repository.GetUserByUsernameAndPassword] error getting user by username and password: %v\n", err)
+		logger.Error.Printf("[repository.GetUserWithPasswordByUsername] error getting user with password by username: %v\n", err)
 		return models.User{}, TranslateError(err)
 	}
 	return user, nil
@@ -123,6 +149,18 @@ func UpdateUserPassword(id uint, newPassword string) (err error) {
 	return nil
 }
 
+func IncrementTokenVersion(id uint) (err error) {
+	err = db.GetDBConn().
+		Model(&models.User{}).
+		Where("id = ?", id).
+		Update("token_version", gorm.Expr("token_version + 1")).Error
+	if err != nil {
+		logger.Error.Printf("[repository.IncrementTokenVersion] Failed to increment token version for user with ID %v: %v\n", id, err)
+		return TranslateError(err)
+	}
+	return nil
+}
+
 func updateBlockStatus(id uint, isBlocked bool) (err error) {
 	err = db.GetDBConn().Model(&models.User{}).Where("id = ?", id).Update("is_blocked", isBlocked).Error
 	if err != nil {
@@ -4,55 +4,236 @@ import (
 	"TajikCareerHub/db"
 	"TajikCareerHub/logger"
 	"TajikCareerHub/models"
+	"TajikCareerHub/utils/errs"
 	"errors"
+	"fmt"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	gormlogger "gorm.io/gorm/logger"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 )
 
-func GetAllVacancies(search string, minSalary int, maxSalary int, location string, category string, sort string) (vacancies []models.Vacancy, err error) {
+var vacancySortColumns = map[string]string{
+	"id":         "vacancies.id",
+	"created_at": "vacancies.created_at",
+	"salary_min": "vacancies.salary_min",
+	"salary_max": "vacancies.salary_max",
+	"title":      "vacancies.title",
+}
+
+// vacancyListed tells whether a vacancy is listed: it isn't deleted or blocked, is published
+// and hasn't expired yet, its author isn't deleted or blocked and its company is verified and
+// isn't deleted or blocked. The expiry date is checked as well, so vacancies waiting for the
+// expiry job aren't shown. The query must join the companies and users of the vacancies.
+func vacancyListed() clause.Expr {
+	return gorm.Expr(`vacancies.deleted_at = false AND vacancies.is_blocked = false
+		AND vacancies.status = ? AND (vacancies.expires_at IS NULL OR vacancies.expires_at > ?)
+		AND users.deleted_at = false AND users.is_blocked = false
+		AND companies.deleted_at = false AND companies.is_blocked = false AND companies.verification_status = ?`,
+		models.VacancyStatusPublished, time.Now(), models.CompanyStatusVerified)
+}
+
+// listedVacancies limits a vacancies query to the vacancies shown in the listings.
+func listedVacancies(db *gorm.DB) *gorm.DB {
+	return db.
+		Joins("JOIN companies ON companies.id = vacancies.company_id").
+		Joins("JOIN users ON users.id = vacancies.user_id").
+		Where(vacancyListed())
+}
+
+// salaryVisible tells whether the viewer sees the salary amounts of a vacancy. The IDs are
+// written into the SQL, so that it can be used in the sort expressions as well.
+func salaryVisible(viewer models.SalaryViewer) string {
+	if viewer.All {
+		return "true"
+	}
+	sql := fmt.Sprintf("(vacancies.salary_hidden = false OR vacancies.user_id = %d", viewer.UserID)
+	if len(viewer.CompanyIDs) > 0 {
+		ids := make([]string, 0, len(viewer.CompanyIDs))
+		for _, companyID := range viewer.CompanyIDs {
+			ids = append(ids, strconv.FormatUint(uint64(companyID), 10))
+		}
+		sql += " OR vacancies.company_id IN (" + strings.Join(ids, ", ") + ")"
+	}
+	return sql + ")"
+}
+
+// GetAllVacancies lists the open vacancies. The salary filter is given as monthly amounts
+// in the base currency of rates. Hidden salaries the viewer doesn't see are left out of the
+// salary filter and sorted as if there was no salary.
+func GetAllVacancies(filter models.VacancyFilter, rates models.ExchangeRates, viewer models.SalaryViewer, params models.PageParams) (vacancies []models.Vacancy, info models.PageInfo, err error) {
 	query := db.GetDBConn().
-		Preload("Company").
-		Preload("VacancyCategory").
-		Preload("User", func(db *gorm.DB) *gorm.DB {
-			return db.Select("id", "full_name", "email")
-		}).
 		Model(&models.Vacancy{}).
-		Where("vacancies.deleted_at = false")
+		Scopes(listedVacancies)
+
+	columns := make(map[string]string, len(vacancySortColumns))
+	for field, expr := range vacancySortColumns {
+		columns[field] = expr
+	}
+	selectColumns := "vacancies.*"
+	visible := salaryVisible(viewer)
+	if !viewer.All {
+		for _, field := range []string{"salary_min", "salary_max"} {
+			masked := "CASE WHEN " + visible + " THEN vacancies." + field + " ELSE 0 END"
+			columns[field] = masked
+			// The masked amount is selected after vacancies.* and replaces the hidden one, so
+			// that it doesn't reach the page cursor.
+			selectColumns += ", " + masked + " AS " + field
+		}
+	}
+	// monthly_salary orders by the lower bound of the salary, or the upper one without it, as
+	// a monthly amount in the base currency. Hidden salaries, vacancies without a salary and
+	// currencies without a rate sort as 0.
+	monthly := monthlyBaseSalary("COALESCE(NULLIF(vacancies.salary_min, 0), NULLIF(vacancies.salary_max, 0))", rates)
+	columns["monthly_salary"] = "COALESCE(CASE WHEN " + visible + " THEN " + inlineVars(monthly) + " END, 0)"
+	selectColumns += ", " + columns["monthly_salary"] + " AS monthly_salary"
+	if filter.Search != "" {
+		query = query.
+			Joins("CROSS JOIN (SELECT websearch_to_tsquery('russian', ?) || websearch_to_tsquery('english', ?) || websearch_to_tsquery('simple', ?) AS query) search", filter.Search, filter.Search, filter.Search).
+			Where("vacancies.search_vector @@ search.query")
+		selectColumns += `,
+			ts_rank(vacancies.search_vector, search.query) AS rank,
+			ts_headline('russian', vacancies.title, search.query, 'HighlightAll=true') AS title_highlight,
+			ts_headline('russian', vacancies.description, search.query, 'MaxFragments=2, MaxWords=20, MinWords=5') AS highlight`
 
-	if search != "" {
-		query = query.Where("title ILIKE ?", "%"+search+"%")
+		columns["rank"] = "ts_rank(vacancies.search_vector, search.query)"
+		if len(params.Sort) == 0 {
+			params.Sort = []models.SortParam{{Field: "rank", Desc: true}}
+		}
+	}
+	if filter.MinSalary > 0 || filter.MaxSalary > 0 {
+		query = query.Where(visible)
+	}
+	if filter.MinSalary > 0 {
+		upper := monthlyBaseSalary("COALESCE(NULLIF(vacancies.salary_max, 0), NULLIF(vacancies.salary_min, 0))", rates)
+		query = query.Where(clause.Expr{SQL: upper.SQL + " >= ?", Vars: append(upper.Vars, filter.MinSalary)})
 	}
-	if minSalary > 0 && maxSalary > 0 {
-		query = query.Where("salary BETWEEN ? AND ?", minSalary, maxSalary)
-	} else if minSalary > 0 {
-		query = query.Where("salary >= ?", minSalary)
-	} else if maxSalary > 0 {
-		query = query.Where("salary <= ?", maxSalary)
+	if filter.MaxSalary > 0 {
+		lower := monthlyBaseSalary("COALESCE(NULLIF(vacancies.salary_min, 0), NULLIF(vacancies.salary_max, 0))", rates)
+		query = query.Where(clause.Expr{SQL: lower.SQL + " <= ?", Vars: append(lower.Vars, filter.MaxSalary)})
 	}
-	if location != "" {
-		query = query.Where("location = ?", location)
+	if filter.LocationID != 0 || filter.Location != "" {
+		query = query.Where(locationFilter("vacancies", filter.LocationID, filter.Location))
 	}
-	if category != "" {
+	if filter.Category != "" {
 		query = query.Joins("JOIN vacancy_categories ON vacancy_categories.id = vacancies.vacancy_category_id").
-			Where("vacancy_categories.name = ?", category)
+			Where("vacancy_categories.name = ?", filter.Category)
+	}
+	for _, skill := range filter.Skills {
+		query = query.Where("EXISTS (SELECT 1 FROM vacancy_skills WHERE vacancy_skills.vacancy_id = vacancies.id AND vacancy_skills.skill_id IN ("+skillIDsByName+"))", skill, skill)
+	}
+	if len(filter.EmploymentTypes) > 0 {
+		query = query.Where("vacancies.employment_type IN ?", filter.EmploymentTypes)
+	}
+	if len(filter.WorkSchedules) > 0 {
+		query = query.Where("vacancies.work_schedule IN ?", filter.WorkSchedules)
+	}
+	if len(filter.RemoteModes) > 0 {
+		query = query.Where("vacancies.remote_mode IN ?", filter.RemoteModes)
+	}
+	if filter.ExperienceYears != nil {
+		query = query.Where("vacancies.min_experience_years <= ? AND (vacancies.max_experience_years IS NULL OR vacancies.max_experience_years >= ?)",
+			*filter.ExperienceYears, *filter.ExperienceYears)
+	}
+	for _, language := range filter.Languages {
+		query = query.Where("EXISTS (SELECT 1 FROM vacancy_languages WHERE vacancy_languages.vacancy_id = vacancies.id AND vacancy_languages.language = ?)", language)
+	}
+	if filter.PublishedAfter != nil {
+		query = query.Where("vacancies.last_published_at > ?", *filter.PublishedAfter)
 	}
-	if sort == "asc" {
-		query = query.Order("salary ASC")
-	} else if sort == "desc" {
-		query = query.Order("salary DESC")
+	if filter.PublishedBefore != nil {
+		query = query.Where("vacancies.last_published_at <= ?", *filter.PublishedBefore)
+	}
+	if len(params.Sort) == 0 {
+		params.Sort = []models.SortParam{{Field: "created_at", Desc: true}}
 	}
 
-	err = query.Find(&vacancies).Error
+	info, err = paginate(query, params, columns, &vacancies, func(db *gorm.DB) *gorm.DB {
+		return db.Select(selectColumns).
+			Preload("Company").
+			Preload("VacancyCategory").
+			Preload("Skills").
+			Preload("Languages").
+			Preload("LocationDetails").
+			Preload("User", func(db *gorm.DB) *gorm.DB {
+				return db.Select("id", "full_name", "email")
+			})
+	})
 	if err != nil {
 		logger.Error.Printf("[repository.GetAllVacancies] Error fetching vacancies: %v", err)
-		return nil, TranslateError(err)
+		return nil, info, err
 	}
-	return vacancies, nil
+	return vacancies, info, nil
+}
+
+// monthlyBaseSalary converts the salary amount expression to a monthly amount in the base
+// currency. Currencies without a rate give NULL, so such vacancies never match a salary filter.
+func monthlyBaseSalary(amount string, rates models.ExchangeRates) clause.Expr {
+	var sql strings.Builder
+	var vars []interface{}
+	sql.WriteString("(" + amount + " * CASE vacancies.currency WHEN ? THEN 1")
+	vars = append(vars, rates.BaseCurrency)
+	currencies := make([]string, 0, len(rates.Rates))
+	for currency := range rates.Rates {
+		currencies = append(currencies, currency)
+	}
+	sort.Strings(currencies)
+	for _, currency := range currencies {
+		if rate, ok := rates.Rate(currency); ok && currency != rates.BaseCurrency {
+			sql.WriteString(" WHEN ? THEN ?")
+			vars = append(vars, currency, rate)
+		}
+	}
+	sql.WriteString(" END * CASE vacancies.salary_period WHEN ? THEN ? ELSE 1 END)")
+	vars = append(vars, models.SalaryPeriodHour, rates.PeriodFactor(models.SalaryPeriodHour))
+	return clause.Expr{SQL: sql.String(), Vars: vars}
+}
+
+// inlineVars writes the vars of expr into its SQL, for the sort expressions, which paginate
+// takes as plain SQL. Strings are quoted and their quotes doubled.
+func inlineVars(expr clause.Expr) string {
+	return gormlogger.ExplainSQL(expr.SQL, nil, "'", expr.Vars...)
+}
+
+// GetManagedVacancies lists the vacancies the user wrote or that belong to the user's
+// companies, in any status.
+func GetManagedVacancies(userID uint, status string, params models.PageParams) (vacancies []models.Vacancy, info models.PageInfo, err error) {
+	query := db.GetDBConn().
+		Model(&models.Vacancy{}).
+		Where("vacancies.deleted_at = false").
+		Where("(vacancies.user_id = ? OR vacancies.company_id IN (SELECT company_id FROM company_members WHERE user_id = ?))", userID, userID)
+	if status != "" {
+		query = query.Where("vacancies.status = ?", status)
+	}
+	if len(params.Sort) == 0 {
+		params.Sort = []models.SortParam{{Field: "created_at", Desc: true}}
+	}
+
+	info, err = paginate(query, params, vacancySortColumns, &vacancies, func(db *gorm.DB) *gorm.DB {
+		return db.Select("vacancies.*").
+			Preload("Company").
+			Preload("VacancyCategory").
+			Preload("Skills").
+			Preload("Languages").
+			Preload("LocationDetails")
+	})
+	if err != nil {
+		logger.Error.Printf("[repository.GetManagedVacancies] Error fetching vacancies of user with ID %v: %v\n", userID, err)
+		return nil, info, err
+	}
+	return vacancies, info, nil
 }
 
 func GetVacancyByID(id uint) (vacancy models.Vacancy, err error) {
 	err = db.GetDBConn().
 		Preload("Company").
 		Preload("VacancyCategory").
+		Preload("Skills.Aliases").
+		Preload("Languages").
+		Preload("LocationDetails.Parent").
 		Preload("User", func(db *gorm.DB) *gorm.DB {
 			return db.Select("id", "full_name", "email") // Исключаем role и password
 		}).
@@ -67,15 +248,52 @@ func GetVacancyByID(id uint) (vacancy models.Vacancy, err error) {
 }
 
 func AddVacancy(vacancy models.Vacancy) (err error) {
-	if err = db.GetDBConn().Create(&vacancy).Error; err != nil {
+	err = db.GetDBConn().Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit("Skills").Create(&vacancy).Error; err != nil {
+			return err
+		}
+		return replaceVacancySkills(tx, vacancy.ID, vacancy.SkillIDs)
+	})
+	if err != nil {
 		logger.Error.Printf("[repository.AddVacancy]: Failed to add vacancy, error: %v\n", err)
 		return TranslateError(err)
 	}
 	return nil
 }
 
+// UpdateVacancy saves the vacancy fields and replaces its languages and skills when
+// Languages and SkillIDs are not nil.
 func UpdateVacancy(vacancyID uint, vacancy models.Vacancy) (err error) {
-	err = db.GetDBConn().Model(&models.Vacancy{}).Where("id = ? AND deleted_at = false", vacancyID).Updates(vacancy).Error
+	err = db.GetDBConn().Transaction(func(tx *gorm.DB) error {
+		// The lifecycle fields are omitted so that an update doesn't undo a concurrent
+		// status change, such as the expiry of the vacancy.
+		err := tx.Model(&models.Vacancy{}).
+			Where("id = ? AND deleted_at = false", vacancyID).
+			Omit(clause.Associations, "status", "published_at", "last_published_at", "expires_at", "closed_at").
+			Updates(vacancy).Error
+		if err != nil {
+			return err
+		}
+		// The salary, the experience range and the location are saved as a whole, including
+		// the zero amounts, flags and the cleared limits and location link Updates skips.
+		err = tx.Model(&models.Vacancy{}).
+			Where("id = ?", vacancyID).
+			Select("salary_min", "salary_max", "currency", "salary_period", "salary_basis", "salary_negotiable", "salary_hidden",
+				"min_experience_years", "max_experience_years", "location", "location_id").
+			Updates(vacancy).Error
+		if err != nil {
+			return err
+		}
+		if vacancy.Languages != nil {
+			if err := replaceVacancyLanguages(tx, vacancyID, vacancy.Languages); err != nil {
+				return err
+			}
+		}
+		if vacancy.SkillIDs == nil {
+			return nil
+		}
+		return replaceVacancySkills(tx, vacancyID, vacancy.SkillIDs)
+	})
 	if err != nil {
 		logger.Error.Printf("[repository.UpdateVacancy]: Failed to update vacancy with ID %v. Error: %v\n", vacancyID, err)
 		return TranslateError(err)
@@ -83,8 +301,70 @@ func UpdateVacancy(vacancyID uint, vacancy models.Vacancy) (err error) {
 	return nil
 }
 
+// UpdateVacancyStatus moves the vacancy from the status it was read with to the status in
+// updates. ErrInvalidStatusTransition is returned when the status has changed since.
+func UpdateVacancyStatus(vacancyID uint, from string, updates map[string]interface{}) (err error) {
+	result := db.GetDBConn().
+		Model(&models.Vacancy{}).
+		Where("id = ? AND status = ? AND deleted_at = false", vacancyID, from).
+		Updates(updates)
+	if result.Error != nil {
+		logger.Error.Printf("[repository.UpdateVacancyStatus] Failed to update status of vacancy with ID %v: %v\n", vacancyID, result.Error)
+		return TranslateError(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		logger.Warning.Printf("[repository.UpdateVacancyStatus] Vacancy with ID %v is no longer %s\n", vacancyID, from)
+		return errs.ErrInvalidStatusTransition
+	}
+	return nil
+}
+
+// ExpireVacancies moves the published vacancies whose expiry date has passed to the expired
+// status and returns them. The notifications built by notify for the expired vacancies are
+// saved in the same transaction.
+func ExpireVacancies(now time.Time, notify func([]models.Vacancy) []models.Notification) (vacancies []models.Vacancy, err error) {
+	err = db.GetDBConn().Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(&vacancies).
+			Clauses(clause.Returning{Columns: []clause.Column{{Name: "id"}, {Name: "title"}, {Name: "user_id"}, {Name: "expires_at"}}}).
+			Where("status = ? AND expires_at <= ? AND deleted_at = false", models.VacancyStatusPublished, now).
+			Updates(map[string]interface{}{"status": models.VacancyStatusExpired, "updated_at": now}).Error
+		if err != nil || len(vacancies) == 0 {
+			return err
+		}
+		notifications := notify(vacancies)
+		if len(notifications) == 0 {
+			return nil
+		}
+		return tx.Create(&notifications).Error
+	})
+	if err != nil {
+		logger.Error.Printf("[repository.ExpireVacancies] Failed to expire vacancies: %v\n", err)
+		return nil, TranslateError(err)
+	}
+	return vacancies, nil
+}
+
+func replaceVacancyLanguages(tx *gorm.DB, vacancyID uint, languages []models.VacancyLanguage) error {
+	if err := tx.Where("vacancy_id = ?", vacancyID).Delete(&models.VacancyLanguage{}).Error; err != nil {
+		return err
+	}
+	if len(languages) == 0 {
+		return nil
+	}
+	for i := range languages {
+		languages[i].VacancyID = vacancyID
+	}
+	return tx.Create(&languages).Error
+}
+
+// DeleteVacancy soft deletes the vacancy and removes it from the users' favorites.
 func DeleteVacancy(vacancyID uint) (err error) {
-	err = db.GetDBConn().Model(&models.Vacancy{}).Where("id = ?", vacancyID).Update("deleted_at", true).Error
+	err = db.GetDBConn().Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&models.Vacancy{}).Where("id = ?", vacancyID).Update("deleted_at", true).Error; err != nil {
+			return err
+		}
+		return tx.Where("vacancy_id = ?", vacancyID).Delete(&models.VacancyFavorite{}).Error
+	})
 	if err != nil {
 		logger.Error.Printf("[repository.DeleteVacancy] Failed to soft delete vacancy with ID %v. Error: %v\n", vacancyID, err)
 		return TranslateError(err)
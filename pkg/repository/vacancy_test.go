@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"TajikCareerHub/models"
+	"reflect"
+	"testing"
+)
+
+func TestMonthlyBaseSalary(t *testing.T) {
+	tests := []struct {
+		name     string
+		rates    models.ExchangeRates
+		wantSQL  string
+		wantVars []interface{}
+	}{
+		{
+			name:     "base currency only",
+			rates:    models.ExchangeRates{BaseCurrency: models.CurrencyTJS},
+			wantSQL:  "(vacancies.salary_min * CASE vacancies.currency WHEN ? THEN 1 END * CASE vacancies.salary_period WHEN ? THEN ? ELSE 1 END)",
+			wantVars: []interface{}{models.CurrencyTJS, models.SalaryPeriodHour, float64(168)},
+		},
+		{
+			// Currencies are listed in a stable order, the base currency and currencies
+			// without a usable rate are left out, so they give NULL.
+			name: "other currencies",
+			rates: models.ExchangeRates{
+				BaseCurrency:  models.CurrencyTJS,
+				Rates:         map[string]float64{models.CurrencyUSD: 10.5, models.CurrencyRUB: 0.12, models.CurrencyTJS: 1, "EUR": 0},
+				HoursPerMonth: 160,
+			},
+			wantSQL: "(vacancies.salary_min * CASE vacancies.currency WHEN ? THEN 1 WHEN ? THEN ? WHEN ? THEN ? END" +
+				" * CASE vacancies.salary_period WHEN ? THEN ? ELSE 1 END)",
+			wantVars: []interface{}{models.CurrencyTJS, models.CurrencyRUB, 0.12, models.CurrencyUSD, 10.5, models.SalaryPeriodHour, float64(160)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expr := monthlyBaseSalary("vacancies.salary_min", tt.rates)
+			if expr.SQL != tt.wantSQL {
+				t.Errorf("SQL = %q, want %q", expr.SQL, tt.wantSQL)
+			}
+			if !reflect.DeepEqual(expr.Vars, tt.wantVars) {
+				t.Errorf("vars = %v, want %v", expr.Vars, tt.wantVars)
+			}
+		})
+	}
+}
+
+func TestSalaryVisible(t *testing.T) {
+	tests := []struct {
+		name   string
+		viewer models.SalaryViewer
+		want   string
+	}{
+		{"admin", models.SalaryViewer{UserID: 1, All: true}, "true"},
+		{"not a company member", models.SalaryViewer{UserID: 7}, "(vacancies.salary_hidden = false OR vacancies.user_id = 7)"},
+		{
+			name:   "company member",
+			viewer: models.SalaryViewer{UserID: 7, CompanyIDs: []uint{3, 12}},
+			want:   "(vacancies.salary_hidden = false OR vacancies.user_id = 7 OR vacancies.company_id IN (3, 12))",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := salaryVisible(tt.viewer); got != tt.want {
+				t.Errorf("salaryVisible() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestInlineVars(t *testing.T) {
+	rates := models.ExchangeRates{BaseCurrency: models.CurrencyTJS, Rates: map[string]float64{models.CurrencyUSD: 10.5, "X'Y": 2}, HoursPerMonth: 160}
+	got := inlineVars(monthlyBaseSalary("vacancies.salary_min", rates))
+	want := "(vacancies.salary_min * CASE vacancies.currency WHEN 'TJS' THEN 1 WHEN 'USD' THEN 10.5 WHEN 'X''Y' THEN 2 END" +
+		" * CASE vacancies.salary_period WHEN 'hour' THEN 160 ELSE 1 END)"
+	if got != want {
+		t.Errorf("inlineVars() = %q, want %q", got, want)
+	}
+}
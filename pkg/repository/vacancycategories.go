@@ -8,15 +8,26 @@ import (
 	"gorm.io/gorm"
 )
 
-func GetAllCategories() (categories []models.VacancyCategory, err error) {
-	err = db.GetDBConn().
-		Where("deleted_at = ?", false).
-		Find(&categories).Error
+var categorySortColumns = map[string]string{
+	"id":         "id",
+	"created_at": "created_at",
+	"name":       "name",
+}
+
+func GetAllCategories(params models.PageParams) (categories []models.VacancyCategory, info models.PageInfo, err error) {
+	query := db.GetDBConn().
+		Model(&models.VacancyCategory{}).
+		Where("deleted_at = ?", false)
+	if len(params.Sort) == 0 {
+		params.Sort = []models.SortParam{{Field: "name"}}
+	}
+
+	info, err = paginate(query, params, categorySortColumns, &categories)
 	if err != nil {
 		logger.Error.Printf("[repository.GetAllCategories]: Error retrieving all Categories. Error: %v\n", err)
-		return nil, TranslateError(err)
+		return nil, info, err
 	}
-	return categories, nil
+	return categories, info, nil
 }
 
 func GetCategoryByID(id uint) (category models.VacancyCategory, err error) {
@@ -0,0 +1,90 @@
+package service
+
+import (
+	"TajikCareerHub/logger"
+	"TajikCareerHub/models"
+	"TajikCareerHub/pkg/repository"
+	"TajikCareerHub/utils/errs"
+	"errors"
+)
+
+// getManagedApplication loads the application and checks that the user manages its vacancy.
+// Notes and ratings are private to the members of the vacancy's company.
+func getManagedApplication(userID uint, applicationID uint) (application models.Application, err error) {
+	if err = checkUserBlocked(userID); err != nil {
+		return models.Application{}, err
+	}
+	application, err = repository.GetApplicationByID(applicationID)
+	if err != nil {
+		return models.Application{}, err
+	}
+	if err = checkVacancyAccess(userID, application.Vacancy); err != nil {
+		return models.Application{}, err
+	}
+	return application, nil
+}
+
+func GetApplicationNotes(userID uint, applicationID uint) (notes []models.ApplicationNote, err error) {
+	if _, err = getManagedApplication(userID, applicationID); err != nil {
+		return nil, err
+	}
+	return repository.GetApplicationNotes(applicationID)
+}
+
+func AddApplicationNote(userID uint, applicationID uint, text string) (err error) {
+	if _, err = getManagedApplication(userID, applicationID); err != nil {
+		return err
+	}
+	note := models.ApplicationNote{
+		ApplicationID: applicationID,
+		AuthorID:      userID,
+		Text:          text,
+	}
+	if err = note.ValidateNote(); err != nil {
+		logger.Error.Printf("[service.AddApplicationNote] validation error: %v\n", err)
+		return err
+	}
+	return repository.AddApplicationNote(note)
+}
+
+func DeleteApplicationNote(userID uint, applicationID uint, noteID uint) (err error) {
+	if _, err = getManagedApplication(userID, applicationID); err != nil {
+		return err
+	}
+	note, err := repository.GetApplicationNoteByID(noteID)
+	if err != nil {
+		return err
+	}
+	if note.ApplicationID != applicationID {
+		return errs.ErrRecordNotFound
+	}
+	if err = checkOwnership(userID, note.AuthorID); err != nil {
+		return err
+	}
+	return repository.DeleteApplicationNote(noteID)
+}
+
+func RateApplication(userID uint, applicationID uint, rating uint) (err error) {
+	if !models.IsValidApplicationRating(rating) {
+		return errs.ErrInvalidRating
+	}
+	if _, err = getManagedApplication(userID, applicationID); err != nil {
+		return err
+	}
+	return repository.UpdateApplicationRating(applicationID, rating)
+}
+
+// attachEmployerFields fills the private notes for the members of the vacancy's company
+// and hides the rating from everyone else, e.g. the applicant.
+func attachEmployerFields(userID uint, application *models.Application) (err error) {
+	if err = checkVacancyAccess(userID, application.Vacancy); err != nil {
+		if errors.Is(err, errs.ErrAccessDenied) {
+			application.Rating = nil
+			application.Notes = nil
+			return nil
+		}
+		return err
+	}
+	application.Notes, err = repository.GetApplicationNotes(application.ID)
+	return err
+}
@@ -4,19 +4,26 @@ import (
 	"TajikCareerHub/logger"
 	"TajikCareerHub/models"
 	"TajikCareerHub/pkg/repository"
+	"TajikCareerHub/utils/errs"
+	"errors"
+	"time"
 )
 
-func GetAllApplications(userID uint) (applications []models.Application, err error) {
+func GetAllApplications(userID uint, params models.PageParams) (applications []models.Application, info models.PageInfo, err error) {
 	err = checkUserBlocked(userID)
 	if err != nil {
 		logger.Error.Printf("[service.GetAllApplications] Error checking user blocked]")
-		return nil, err
+		return nil, info, err
+	}
+	// Everyone else sees applications through the received applications lists.
+	if err = checkOwnership(userID); err != nil {
+		return nil, info, err
 	}
-	applications, err = repository.GetAllApplications()
+	applications, info, err = repository.GetAllApplications(params)
 	if err != nil {
-		return nil, err
+		return nil, info, err
 	}
-	return applications, nil
+	return applications, info, nil
 }
 
 func GetApplicationByID(userID, id uint) (application models.Application, err error) {
@@ -28,14 +35,96 @@ func GetApplicationByID(userID, id uint) (application models.Application, err er
 	if err != nil {
 		return application, err
 	}
+	if err := checkApplicationAccess(userID, application); err != nil {
+		return models.Application{}, err
+	}
+	if err := attachEmployerFields(userID, &application); err != nil {
+		return models.Application{}, err
+	}
 	return application, nil
 }
 
-func AddApplication(application models.Application) (err error) {
-	err = checkUserBlocked(application.UserID)
+// checkApplicationAccess allows the applicant and everyone who manages the vacancy to see the application.
+func checkApplicationAccess(userID uint, application models.Application) (err error) {
+	if application.UserID == userID {
+		return nil
+	}
+	return checkVacancyAccess(userID, application.Vacancy)
+}
+
+func GetVacancyApplications(userID uint, vacancyID uint, filter models.ApplicationFilter, params models.PageParams) (applications []models.Application, info models.PageInfo, err error) {
+	if err = checkUserBlocked(userID); err != nil {
+		return nil, info, err
+	}
+	vacancy, err := repository.GetVacancyByID(vacancyID)
+	if err != nil {
+		return nil, info, err
+	}
+	if err = checkVacancyAccess(userID, vacancy); err != nil {
+		return nil, info, err
+	}
+	if filter.Status != "" && !models.IsValidApplicationStatus(filter.Status) {
+		return nil, info, errs.ErrInvalidApplicationStatus
+	}
+	filter.VacancyID = vacancyID
+	filter.EmployerID = 0
+	return repository.GetReceivedApplications(filter, params)
+}
+
+func GetReceivedApplications(userID uint, filter models.ApplicationFilter, params models.PageParams) (applications []models.Application, info models.PageInfo, err error) {
+	if err = checkUserBlocked(userID); err != nil {
+		return nil, info, err
+	}
+	if filter.Status != "" && !models.IsValidApplicationStatus(filter.Status) {
+		return nil, info, errs.ErrInvalidApplicationStatus
+	}
+	filter.VacancyID = 0
+	filter.EmployerID = userID
+	return repository.GetReceivedApplications(filter, params)
+}
+
+func AddApplication(userID uint, application models.Application) (err error) {
+	err = checkUserBlocked(userID)
+	if err != nil {
+		return err
+	}
+	application.UserID = userID
+	if err = application.ValidateApplication(); err != nil {
+		logger.Error.Printf("[service.AddApplication] validation error: %v\n", err)
+		return err
+	}
+	vacancy, err := repository.GetVacancyByID(application.VacancyID)
+	if err != nil {
+		if errors.Is(err, errs.ErrRecordNotFound) {
+			return errs.ErrVacancyNotFound
+		}
+		return err
+	}
+	if vacancy.IsBlocked {
+		return errs.ErrVacancyBlocked
+	}
+	if !vacancy.IsOpen(time.Now()) {
+		return errs.ErrVacancyNotOpen
+	}
+	if err = checkCompanyAvailable(vacancy.Company); err != nil {
+		return err
+	}
+	if err = checkApplicationResume(userID, application.ResumeID); err != nil {
+		return err
+	}
+	applied, err := repository.HasActiveApplication(userID, application.VacancyID)
+	if err != nil {
+		return err
+	}
+	if applied {
+		return errs.ErrAlreadyApplied
+	}
+
+	status, err := repository.GetApplicationStatusByName(models.ApplicationStatusApplied)
 	if err != nil {
 		return err
 	}
+	application.StatusID = status.ID
 	err = repository.AddApplication(application)
 	if err != nil {
 		return err
@@ -43,11 +132,36 @@ func AddApplication(application models.Application) (err error) {
 	return nil
 }
 
-func UpdateApplication(application models.Application) (err error) {
-	err = checkUserBlocked(application.UserID)
+func UpdateApplication(userID uint, application models.Application) (err error) {
+	err = checkUserBlocked(userID)
 	if err != nil {
 		return err
 	}
+	existingApplication, err := repository.GetApplicationByID(application.ID)
+	if err != nil {
+		return err
+	}
+	if err := checkOwnership(userID, existingApplication.UserID); err != nil {
+		return err
+	}
+	if err = application.ValidateApplication(); err != nil {
+		logger.Error.Printf("[service.UpdateApplication] validation error: %v\n", err)
+		return err
+	}
+	if application.ResumeID != 0 && application.ResumeID != existingApplication.ResumeID {
+		// The employer may already be reviewing the resume the applicant applied with.
+		if existingApplication.Status.Name != models.ApplicationStatusApplied {
+			return errs.ErrApplicationResumeLocked
+		}
+		if err = checkApplicationResume(existingApplication.UserID, application.ResumeID); err != nil {
+			return err
+		}
+	}
+	application.UserID = existingApplication.UserID
+	// The vacancy can't be changed, and status changes go through UpdateApplicationStatus
+	// so that they are validated and recorded.
+	application.VacancyID = 0
+	application.StatusID = 0
 
 	err = repository.UpdateApplication(application.ID, application)
 	if err != nil {
@@ -56,11 +170,46 @@ func UpdateApplication(application models.Application) (err error) {
 	return nil
 }
 
+// checkApplicationResume makes sure the applicant applies with their own available resume.
+func checkApplicationResume(userID uint, resumeID uint) (err error) {
+	resume, err := repository.GetResumeByID(resumeID)
+	if err != nil {
+		if errors.Is(err, errs.ErrRecordNotFound) {
+			return errs.ErrResumeNotFound
+		}
+		return err
+	}
+	if resume.UserID != userID {
+		logger.Warning.Printf("[service.checkApplicationResume] User with ID %d tried to apply with resume %d of user %d\n", userID, resumeID, resume.UserID)
+		return errs.ErrAccessDenied
+	}
+	if resume.IsBlocked {
+		return errs.ErrResumeBlocked
+	}
+	return nil
+}
+
+// DeleteApplication withdraws the application when the applicant deletes it, so that it stays
+// in the employer's history and the applicant can't apply to the vacancy again. Only users
+// who can manage any resource remove applications.
 func DeleteApplication(id, userID uint) (err error) {
 	err = checkUserBlocked(userID)
 	if err != nil {
 		return err
 	}
+	application, err := repository.GetApplicationByID(id)
+	if err != nil {
+		return err
+	}
+	if application.UserID == userID {
+		if application.Status.Name == models.ApplicationStatusWithdrawn {
+			return nil
+		}
+		return WithdrawApplication(userID, id)
+	}
+	if err := checkOwnership(userID, application.UserID); err != nil {
+		return err
+	}
 
 	err = repository.DeleteApplication(id)
 	if err != nil {
@@ -74,10 +223,83 @@ func UpdateApplicationStatus(applicationID uint, statusID uint, userID uint) (er
 	if err != nil {
 		return err
 	}
+	application, err := repository.GetApplicationByID(applicationID)
+	if err != nil {
+		return err
+	}
+	if err := checkVacancyAccess(userID, application.Vacancy); err != nil {
+		return err
+	}
 
-	err = repository.UpdateApplicationStatus(applicationID, statusID)
+	status, err := repository.GetApplicationStatusByID(statusID)
 	if err != nil {
+		if errors.Is(err, errs.ErrRecordNotFound) {
+			return errs.ErrInvalidApplicationStatus
+		}
 		return err
 	}
-	return nil
+	if status.Name == models.ApplicationStatusWithdrawn {
+		logger.Warning.Printf("[service.UpdateApplicationStatus] User with ID %d tried to withdraw application %d on behalf of the applicant\n", userID, applicationID)
+		return errs.ErrInvalidStatusTransition
+	}
+	return changeApplicationStatus(application, status, userID)
+}
+
+func changeApplicationStatus(application models.Application, status models.ApplicationStatus, userID uint) (err error) {
+	if !models.CanTransitionApplicationStatus(application.Status.Name, status.Name) {
+		logger.Warning.Printf("[service.changeApplicationStatus] Transition of application %d from %s to %s is not allowed\n", application.ID, application.Status.Name, status.Name)
+		return errs.ErrInvalidStatusTransition
+	}
+	return repository.UpdateApplicationStatus(application.ID, application.StatusID, status.ID, userID)
+}
+
+func GetApplicationStatusHistory(userID, applicationID uint) (history []models.ApplicationStatusHistory, err error) {
+	err = checkUserBlocked(userID)
+	if err != nil {
+		return nil, err
+	}
+	application, err := repository.GetApplicationByID(applicationID)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkApplicationAccess(userID, application); err != nil {
+		return nil, err
+	}
+	return repository.GetApplicationStatusHistory(applicationID)
+}
+
+func GetMyApplications(userID uint, status string, params models.PageParams) (applications []models.Application, info models.PageInfo, err error) {
+	if err = checkUserBlocked(userID); err != nil {
+		return nil, info, err
+	}
+	if status != "" && !models.IsValidApplicationStatus(status) {
+		return nil, info, errs.ErrInvalidApplicationStatus
+	}
+	applications, info, err = repository.GetApplicationsByUser(userID, status, params)
+	if err != nil {
+		return nil, info, err
+	}
+	for i := range applications {
+		applications[i].Rating = nil
+	}
+	return applications, info, nil
+}
+
+func WithdrawApplication(userID uint, applicationID uint) (err error) {
+	if err = checkUserBlocked(userID); err != nil {
+		return err
+	}
+	application, err := repository.GetApplicationByID(applicationID)
+	if err != nil {
+		return err
+	}
+	if application.UserID != userID {
+		logger.Warning.Printf("[service.WithdrawApplication] User with ID %d tried to withdraw application %d of user %d\n", userID, applicationID, application.UserID)
+		return errs.ErrAccessDenied
+	}
+	status, err := repository.GetApplicationStatusByName(models.ApplicationStatusWithdrawn)
+	if err != nil {
+		return err
+	}
+	return changeApplicationStatus(application, status, userID)
 }
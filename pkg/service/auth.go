@@ -2,27 +2,66 @@ package service
 
 import (
 	"TajikCareerHub/logger"
+	"TajikCareerHub/models"
 	"TajikCareerHub/pkg/repository"
 	"TajikCareerHub/utils"
 	"TajikCareerHub/utils/errs"
+	"errors"
 )
 
-func SignIn(username, password string) (accessToken string, err error) {
-	password = utils.GenerateHash(password)
-	user, err := repository.GetUserByUsernameAndPassword(username, password)
+func SignIn(username, password string) (accessToken string, refreshToken string, err error) {
+	user, err := authenticateUser(username, password)
 	if err != nil {
-		return "", err
+		return "", "", err
 	}
 	if err := checkUserBlocked(user.ID); err != nil {
 		logger.Error.Printf("[service.SignIn]: Error user blocked")
-		return "", errs.ErrUserBlocked
+		return "", "", errs.ErrUserBlocked
 	}
-	accessToken, err = GenerateToken(user.ID, user.UserName, user.RoleID)
+	accessToken, err = GenerateToken(user.ID, user.UserName, user.RoleID, user.TokenVersion)
 	if err != nil {
 		logger.Error.Printf("[service.SignIn]: Error generating access token")
-		return "", err
+		return "", "", err
 	}
-	return accessToken, nil
+	refreshToken, err = CreateRefreshToken(user.ID)
+	if err != nil {
+		logger.Error.Printf("[service.SignIn]: Error generating refresh token")
+		return "", "", err
+	}
+	return accessToken, refreshToken, nil
+}
+
+func authenticateUser(username, password string) (user models.User, err error) {
+	user, err = repository.GetUserWithPasswordByUsername(username)
+	if err != nil {
+		if errors.Is(err, errs.ErrRecordNotFound) {
+			return models.User{}, errs.ErrIncorrectUsernameOrPassword
+		}
+		return models.User{}, err
+	}
+
+	ok, needsRehash, err := utils.VerifyPassword(password, user.Password)
+	if err != nil {
+		logger.Error.Printf("[service.authenticateUser] Error verifying password for user with ID %d: %v\n", user.ID, err)
+		return models.User{}, errs.ErrIncorrectUsernameOrPassword
+	}
+	if !ok {
+		return models.User{}, errs.ErrIncorrectUsernameOrPassword
+	}
+
+	if needsRehash {
+		hashedPassword, err := utils.HashPassword(password)
+		if err != nil {
+			logger.Error.Printf("[service.authenticateUser] Error rehashing password for user with ID %d: %v\n", user.ID, err)
+			return user, nil
+		}
+		if err := repository.UpdateUserPassword(user.ID, hashedPassword); err != nil {
+			logger.Error.Printf("[service.authenticateUser] Error saving rehashed password for user with ID %d: %v\n", user.ID, err)
+			return user, nil
+		}
+		logger.Info.Printf("[service.authenticateUser] Password hash upgraded for user with ID %d\n", user.ID)
+	}
+	return user, nil
 }
 
 func checkUserBlocked(userID uint) (err error) {
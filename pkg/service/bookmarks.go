@@ -0,0 +1,133 @@
+package service
+
+import (
+	"TajikCareerHub/models"
+	"TajikCareerHub/pkg/repository"
+	"TajikCareerHub/utils/errs"
+	"errors"
+	"time"
+)
+
+// GetMyVacancyFavorites lists the user's favorite vacancies, optionally only those with the
+// tag. Vacancies that can't be viewed anymore are listed without their details.
+func GetMyVacancyFavorites(userID uint, tag string, params models.PageParams) ([]models.VacancyFavorite, models.PageInfo, error) {
+	if err := checkUserBlocked(userID); err != nil {
+		return nil, models.PageInfo{}, err
+	}
+	favorites, info, err := repository.GetVacancyFavorites(userID, models.NormalizeBookmarkTag(tag), params)
+	if err != nil {
+		return nil, info, err
+	}
+	viewer, err := getSalaryViewer(userID)
+	if err != nil {
+		return nil, info, err
+	}
+	for i := range favorites {
+		if !favorites[i].Available || favorites[i].Vacancy == nil {
+			favorites[i].Vacancy = nil
+			continue
+		}
+		hideSalary(viewer, favorites[i].Vacancy)
+	}
+	return favorites, info, nil
+}
+
+// SaveVacancyFavorite adds an open vacancy to the user's favorites or, when it is there
+// already, replaces its tags and note.
+func SaveVacancyFavorite(userID uint, vacancyID uint, input models.SwagBookmark) (err error) {
+	if err = checkUserBlocked(userID); err != nil {
+		return err
+	}
+	input = input.Normalize()
+	if err = input.ValidateBookmark(); err != nil {
+		return err
+	}
+
+	vacancy, err := repository.GetVacancyByID(vacancyID)
+	if err != nil {
+		if errors.Is(err, errs.ErrRecordNotFound) {
+			return errs.ErrVacancyNotFound
+		}
+		return err
+	}
+	if vacancy.IsBlocked {
+		return errs.ErrVacancyBlocked
+	}
+	if err = checkCompanyAvailable(vacancy.Company); err != nil {
+		return err
+	}
+	if !vacancy.IsOpen(time.Now()) {
+		return errs.ErrVacancyNotOpen
+	}
+
+	return repository.SaveVacancyFavorite(&models.VacancyFavorite{
+		UserID:    userID,
+		VacancyID: vacancyID,
+		Title:     vacancy.Title,
+		Tags:      input.Tags,
+		Note:      input.Note,
+	})
+}
+
+func RemoveVacancyFavorite(userID uint, vacancyID uint) (err error) {
+	if err = checkUserBlocked(userID); err != nil {
+		return err
+	}
+	return repository.RemoveVacancyFavorite(userID, vacancyID)
+}
+
+// GetMyResumeShortlist lists the resumes the user shortlisted, optionally only those with the
+// tag. Resumes that can't be viewed anymore are listed without their details.
+func GetMyResumeShortlist(userID uint, tag string, params models.PageParams) ([]models.ResumeShortlistItem, models.PageInfo, error) {
+	if err := checkUserBlocked(userID); err != nil {
+		return nil, models.PageInfo{}, err
+	}
+	items, info, err := repository.GetResumeShortlist(userID, models.NormalizeBookmarkTag(tag), params)
+	if err != nil {
+		return nil, info, err
+	}
+	for i := range items {
+		if !items[i].Available {
+			items[i].Resume = nil
+		}
+	}
+	return items, info, nil
+}
+
+// SaveResumeShortlistItem adds a resume to the user's shortlist or, when it is there already,
+// replaces its tags and note.
+func SaveResumeShortlistItem(userID uint, resumeID uint, input models.SwagBookmark) (err error) {
+	if err = checkUserBlocked(userID); err != nil {
+		return err
+	}
+	input = input.Normalize()
+	if err = input.ValidateBookmark(); err != nil {
+		return err
+	}
+
+	resume, err := repository.GetResumeByID(resumeID)
+	if err != nil {
+		if errors.Is(err, errs.ErrRecordNotFound) {
+			return errs.ErrResumeNotFound
+		}
+		return err
+	}
+	if resume.IsBlocked {
+		return errs.ErrResumeBlocked
+	}
+
+	return repository.SaveResumeShortlistItem(&models.ResumeShortlistItem{
+		UserID:   userID,
+		ResumeID: resumeID,
+		Title:    resume.Title,
+		Tags:     input.Tags,
+		Note:     input.Note,
+	})
+}
+
+func RemoveResumeShortlistItem(userID uint, resumeID uint) (err error) {
+	if err = checkUserBlocked(userID); err != nil {
+		return err
+	}
+	return repository.RemoveResumeShortlistItem(userID, resumeID)
+}
@@ -1,21 +1,22 @@
 package service
 
 import (
+	"TajikCareerHub/logger"
 	"TajikCareerHub/models"
 	"TajikCareerHub/pkg/repository"
 	"TajikCareerHub/utils/errs"
 )
 
-func GetAllCompanies(userID uint) (companies []models.Company, err error) {
+func GetAllCompanies(userID uint, params models.PageParams) (companies []models.Company, info models.PageInfo, err error) {
 	err = checkUserBlocked(userID)
 	if err != nil {
-		return companies, err
+		return companies, info, err
 	}
-	companies, err = repository.GetAllCompanies()
+	companies, info, err = repository.GetAllCompanies(params)
 	if err != nil {
-		return nil, err
+		return nil, info, err
 	}
-	return companies, nil
+	return companies, info, nil
 }
 
 func GetCompanyByID(id uint, userID uint) (company models.Company, err error) {
@@ -33,48 +34,61 @@ func GetCompanyByID(id uint, userID uint) (company models.Company, err error) {
 	return company, nil
 }
 
-func AddCompany(userID uint, company models.Company, RoleID uint) (err error) {
+func AddCompany(userID uint, company models.Company) (err error) {
 	err = checkUserBlocked(userID)
 	if err != nil {
 		return err
 	}
+	company.VerificationStatus = models.CompanyStatusPending
+	company.ReviewerNote = ""
+	company.ReviewedByID = nil
+	company.ReviewedAt = nil
+	company.IsBlocked = false
 
-	if RoleID != 2 && RoleID != 1 {
-		return errs.ErrAccessDenied
-	}
-
-	err = repository.AddCompany(company)
+	err = repository.AddCompany(company, userID)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-func UpdateCompany(userID uint, company models.Company, RoleID uint) (err error) {
+func UpdateCompany(userID uint, company models.Company) (err error) {
 	err = checkUserBlocked(userID)
 	if err != nil {
 		return err
 	}
-
-	if RoleID != 2 && RoleID != 1 {
-		return errs.ErrAccessDenied
+	current, err := repository.GetCompanyByID(company.ID)
+	if err != nil {
+		return err
 	}
+	if err = checkCompanyMembership(userID, company.ID, models.CompanyMemberRoleOwner); err != nil {
+		return err
+	}
+	company.VerificationStatus = ""
+	company.ReviewerNote = ""
+	company.ReviewedByID = nil
+	company.ReviewedAt = nil
+	company.IsBlocked = false
 
-	err = repository.UpdateCompany(company)
+	// The verification confirms the company's name, a renamed company is reviewed again.
+	renamed := company.Name != "" && company.Name != current.Name
+	err = repository.UpdateCompany(company, renamed)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-func DeleteCompany(id uint, userID uint, RoleID uint) (err error) {
+func DeleteCompany(id uint, userID uint) (err error) {
 	err = checkUserBlocked(userID)
 	if err != nil {
 		return err
 	}
-
-	if RoleID != 2 && RoleID != 1 {
-		return errs.ErrAccessDenied
+	if _, err = repository.GetCompanyByID(id); err != nil {
+		return err
+	}
+	if err = checkCompanyMembership(userID, id, models.CompanyMemberRoleOwner); err != nil {
+		return err
 	}
 
 	err = repository.DeleteCompany(id)
@@ -83,3 +97,53 @@ func DeleteCompany(id uint, userID uint, RoleID uint) (err error) {
 	}
 	return nil
 }
+
+func VerifyCompany(userID uint, companyID uint, verification models.SwagCompanyVerification) (err error) {
+	if err = checkUserBlocked(userID); err != nil {
+		return err
+	}
+	if verification.Status != models.CompanyStatusVerified && verification.Status != models.CompanyStatusRejected {
+		return errs.ErrInvalidVerificationStatus
+	}
+	if _, err = repository.GetCompanyByID(companyID); err != nil {
+		return err
+	}
+	err = repository.VerifyCompany(companyID, verification.Status, verification.Note, userID)
+	if err != nil {
+		logger.Error.Printf("[service.VerifyCompany] Failed to review company with ID %v: %v", companyID, err)
+		return err
+	}
+	return nil
+}
+
+func BlockCompany(userID uint, companyID uint) (err error) {
+	if err = checkUserBlocked(userID); err != nil {
+		return err
+	}
+	if _, err = repository.GetCompanyByID(companyID); err != nil {
+		return err
+	}
+	return repository.BlockCompany(companyID)
+}
+
+func UnblockCompany(userID uint, companyID uint) (err error) {
+	if err = checkUserBlocked(userID); err != nil {
+		return err
+	}
+	if _, err = repository.GetCompanyByID(companyID); err != nil {
+		return err
+	}
+	return repository.UnblockCompany(companyID)
+}
+
+func checkCompanyAvailable(company models.Company) (err error) {
+	if company.IsBlocked {
+		logger.Info.Printf("[service.checkCompanyAvailable] Company with ID %d is blocked.\n", company.ID)
+		return errs.ErrCompanyBlocked
+	}
+	if company.VerificationStatus != models.CompanyStatusVerified {
+		logger.Info.Printf("[service.checkCompanyAvailable] Company with ID %d is not verified.\n", company.ID)
+		return errs.ErrCompanyNotVerified
+	}
+	return nil
+}
@@ -0,0 +1,188 @@
+package service
+
+import (
+	"TajikCareerHub/logger"
+	"TajikCareerHub/models"
+	"TajikCareerHub/pkg/repository"
+	"TajikCareerHub/utils/errs"
+	"errors"
+	"strings"
+)
+
+func checkCompanyMembership(userID uint, companyID uint, roles ...string) (err error) {
+	isAdmin, err := canManageAnyResource(userID)
+	if err != nil {
+		return err
+	}
+	if isAdmin {
+		return nil
+	}
+
+	member, err := repository.GetCompanyMember(companyID, userID)
+	if err != nil {
+		if errors.Is(err, errs.ErrRecordNotFound) {
+			return errs.ErrNotCompanyMember
+		}
+		return err
+	}
+	if len(roles) == 0 {
+		return nil
+	}
+	for _, role := range roles {
+		if member.Role == role {
+			return nil
+		}
+	}
+	logger.Warning.Printf("[service.checkCompanyMembership] User with ID %d has role %s in company %d, required one of %v\n", userID, member.Role, companyID, roles)
+	return errs.ErrAccessDenied
+}
+
+func GetCompanyMembers(userID uint, companyID uint) (members []models.CompanyMember, err error) {
+	if err = checkUserBlocked(userID); err != nil {
+		return nil, err
+	}
+	if _, err = repository.GetCompanyByID(companyID); err != nil {
+		return nil, err
+	}
+	if err = checkCompanyMembership(userID, companyID); err != nil {
+		return nil, err
+	}
+	return repository.GetCompanyMembers(companyID)
+}
+
+func InviteCompanyMember(userID uint, companyID uint, input models.SwagCompanyInvitation) (err error) {
+	if err = checkUserBlocked(userID); err != nil {
+		return err
+	}
+	if _, err = repository.GetCompanyByID(companyID); err != nil {
+		return err
+	}
+	if err = checkCompanyMembership(userID, companyID, models.CompanyMemberRoleOwner); err != nil {
+		return err
+	}
+
+	role := input.Role
+	if role == "" {
+		role = models.CompanyMemberRoleRecruiter
+	}
+	if !models.IsValidCompanyMemberRole(role) {
+		return errs.ErrInvalidCompanyMemberRole
+	}
+
+	var invitee *models.User
+	switch {
+	case strings.TrimSpace(input.UserName) != "":
+		invitee, err = repository.GetUserByUsername(strings.TrimSpace(input.UserName))
+	case strings.TrimSpace(input.Email) != "":
+		invitee, err = repository.GetUserByEmail(strings.TrimSpace(input.Email))
+	default:
+		return errs.ErrIncorrectInput
+	}
+	if err != nil {
+		if errors.Is(err, errs.ErrRecordNotFound) {
+			return errs.ErrUserNotFound
+		}
+		return err
+	}
+	if invitee.IsBlocked {
+		return errs.ErrUserBlocked
+	}
+	if invitee.Role.Name != models.RoleEmployer {
+		return errs.ErrInvalidRole
+	}
+
+	_, err = repository.GetCompanyMember(companyID, invitee.ID)
+	if err == nil {
+		return errs.ErrAlreadyCompanyMember
+	}
+	if !errors.Is(err, errs.ErrRecordNotFound) {
+		return err
+	}
+	pending, err := repository.HasPendingCompanyInvitation(companyID, invitee.ID)
+	if err != nil {
+		return err
+	}
+	if pending {
+		return errs.ErrInvitationAlreadyPending
+	}
+
+	return repository.CreateCompanyInvitation(models.CompanyInvitation{
+		CompanyID:   companyID,
+		UserID:      invitee.ID,
+		InvitedByID: userID,
+		Role:        role,
+		Status:      models.InvitationStatusPending,
+	})
+}
+
+func RemoveCompanyMember(userID uint, companyID uint, memberUserID uint) (err error) {
+	if err = checkUserBlocked(userID); err != nil {
+		return err
+	}
+	if userID != memberUserID {
+		if err = checkCompanyMembership(userID, companyID, models.CompanyMemberRoleOwner); err != nil {
+			return err
+		}
+	}
+
+	member, err := repository.GetCompanyMember(companyID, memberUserID)
+	if err != nil {
+		if errors.Is(err, errs.ErrRecordNotFound) {
+			return errs.ErrNotCompanyMember
+		}
+		return err
+	}
+	if member.Role == models.CompanyMemberRoleOwner {
+		owners, err := repository.CountCompanyOwners(companyID)
+		if err != nil {
+			return err
+		}
+		if owners <= 1 {
+			return errs.ErrCannotRemoveLastOwner
+		}
+	}
+	return repository.DeleteCompanyMember(companyID, memberUserID)
+}
+
+func GetMyCompanyInvitations(userID uint) (invitations []models.CompanyInvitation, err error) {
+	if err = checkUserBlocked(userID); err != nil {
+		return nil, err
+	}
+	return repository.GetPendingInvitationsByUser(userID)
+}
+
+func getPendingInvitation(userID uint, invitationID uint) (invitation models.CompanyInvitation, err error) {
+	if err = checkUserBlocked(userID); err != nil {
+		return models.CompanyInvitation{}, err
+	}
+	invitation, err = repository.GetCompanyInvitationByID(invitationID)
+	if err != nil {
+		return models.CompanyInvitation{}, err
+	}
+	if invitation.UserID != userID {
+		return models.CompanyInvitation{}, errs.ErrAccessDenied
+	}
+	if invitation.Status != models.InvitationStatusPending {
+		return models.CompanyInvitation{}, errs.ErrInvitationNotPending
+	}
+	return invitation, nil
+}
+
+func AcceptCompanyInvitation(userID uint, invitationID uint) (err error) {
+	invitation, err := getPendingInvitation(userID, invitationID)
+	if err != nil {
+		return err
+	}
+	if _, err = repository.GetCompanyByID(invitation.CompanyID); err != nil {
+		return err
+	}
+	return repository.AcceptCompanyInvitation(invitation)
+}
+
+func DeclineCompanyInvitation(userID uint, invitationID uint) (err error) {
+	invitation, err := getPendingInvitation(userID, invitationID)
+	if err != nil {
+		return err
+	}
+	return repository.UpdateCompanyInvitationStatus(invitation.ID, models.InvitationStatusDeclined)
+}
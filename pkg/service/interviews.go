@@ -0,0 +1,224 @@
+package service
+
+import (
+	"TajikCareerHub/configs"
+	"TajikCareerHub/logger"
+	"TajikCareerHub/models"
+	"TajikCareerHub/pkg/repository"
+	"TajikCareerHub/utils"
+	"TajikCareerHub/utils/errs"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+func ScheduleInterview(userID uint, applicationID uint, interview models.Interview) (models.Interview, error) {
+	application, err := getManagedApplication(userID, applicationID)
+	if err != nil {
+		return models.Interview{}, err
+	}
+	moveToInterview := application.Status.Name != models.ApplicationStatusInterview
+	if moveToInterview && !models.CanTransitionApplicationStatus(application.Status.Name, models.ApplicationStatusInterview) {
+		logger.Warning.Printf("[service.ScheduleInterview] Application %d in status %s can't be moved to interview\n", applicationID, application.Status.Name)
+		return models.Interview{}, errs.ErrInvalidStatusTransition
+	}
+
+	if interview.InterviewerID == 0 {
+		interview.InterviewerID = userID
+	}
+	if err = checkInterviewer(interview.InterviewerID, application.Vacancy); err != nil {
+		return models.Interview{}, err
+	}
+	if interview.Timezone == "" {
+		interview.Timezone = models.DefaultInterviewTimezone
+	}
+	interview.ApplicationID = applicationID
+	interview.CreatedByID = userID
+	interview.Outcome = models.InterviewOutcomePending
+	if err = validateInterviewSlot(interview); err != nil {
+		return models.Interview{}, err
+	}
+
+	var statusChange *models.ApplicationStatusHistory
+	if moveToInterview {
+		status, err := repository.GetApplicationStatusByName(models.ApplicationStatusInterview)
+		if err != nil {
+			return models.Interview{}, err
+		}
+		statusChange = &models.ApplicationStatusHistory{
+			ApplicationID: applicationID,
+			FromStatusID:  &application.StatusID,
+			ToStatusID:    status.ID,
+			ChangedByID:   userID,
+		}
+	}
+	if err = repository.CreateInterview(&interview, application.Vacancy.CompanyID, statusChange); err != nil {
+		return models.Interview{}, err
+	}
+	return interview, nil
+}
+
+func GetApplicationInterviews(userID uint, applicationID uint) (interviews []models.Interview, err error) {
+	if err = checkUserBlocked(userID); err != nil {
+		return nil, err
+	}
+	application, err := repository.GetApplicationByID(applicationID)
+	if err != nil {
+		return nil, err
+	}
+	if err = checkApplicationAccess(userID, application); err != nil {
+		return nil, err
+	}
+	interviews, err = repository.GetInterviewsByApplication(applicationID)
+	if err != nil {
+		return nil, err
+	}
+	// Interview feedback is private to the employer.
+	if err = checkVacancyAccess(userID, application.Vacancy); err != nil {
+		if !errors.Is(err, errs.ErrAccessDenied) {
+			return nil, err
+		}
+		for i := range interviews {
+			interviews[i].Feedback = ""
+		}
+	}
+	return interviews, nil
+}
+
+func RescheduleInterview(userID uint, interviewID uint, update models.Interview) (err error) {
+	interview, err := getManagedInterview(userID, interviewID)
+	if err != nil {
+		return err
+	}
+	if interview.Outcome != models.InterviewOutcomePending {
+		return errs.ErrInterviewNotPending
+	}
+
+	if !update.StartsAt.IsZero() {
+		interview.StartsAt = update.StartsAt
+		interview.EndsAt = update.EndsAt
+	}
+	if update.Timezone != "" {
+		interview.Timezone = update.Timezone
+	}
+	if update.Location != "" {
+		interview.Location = update.Location
+	}
+	if update.MeetingLink != "" {
+		interview.MeetingLink = update.MeetingLink
+	}
+	if update.InterviewerID != 0 && update.InterviewerID != interview.InterviewerID {
+		if err = checkInterviewer(update.InterviewerID, interview.Application.Vacancy); err != nil {
+			return err
+		}
+		interview.InterviewerID = update.InterviewerID
+	}
+	if err = validateInterviewSlot(interview); err != nil {
+		return err
+	}
+
+	return repository.RescheduleInterview(interview, interview.Application.Vacancy.CompanyID)
+}
+
+func SetInterviewOutcome(userID uint, interviewID uint, outcome string, feedback string) (err error) {
+	if outcome == models.InterviewOutcomePending || !models.IsValidInterviewOutcome(outcome) {
+		return errs.ErrInvalidInterviewOutcome
+	}
+	interview, err := getManagedInterview(userID, interviewID)
+	if err != nil {
+		return err
+	}
+	if interview.Outcome != models.InterviewOutcomePending {
+		return errs.ErrInterviewNotPending
+	}
+	return repository.UpdateInterview(interviewID, map[string]interface{}{
+		"outcome":  outcome,
+		"feedback": feedback,
+	})
+}
+
+// GetInterviewICS exports the interview as an iCalendar file for the candidate and the employer.
+func GetInterviewICS(userID uint, interviewID uint) ([]byte, error) {
+	if err := checkUserBlocked(userID); err != nil {
+		return nil, err
+	}
+	interview, err := repository.GetInterviewByID(interviewID)
+	if err != nil {
+		if errors.Is(err, errs.ErrRecordNotFound) {
+			return nil, errs.ErrInterviewNotFound
+		}
+		return nil, err
+	}
+	if err = checkApplicationAccess(userID, interview.Application); err != nil {
+		return nil, err
+	}
+
+	vacancy := interview.Application.Vacancy
+	description := []string{fmt.Sprintf("Interview for the vacancy \"%s\".", vacancy.Title)}
+	if interview.Interviewer.FullName != "" {
+		description = append(description, "Interviewer: "+interview.Interviewer.FullName)
+	}
+	if interview.MeetingLink != "" {
+		description = append(description, "Meeting link: "+interview.MeetingLink)
+	}
+	location := interview.Location
+	if location == "" {
+		location = interview.MeetingLink
+	}
+
+	event := utils.CalendarEvent{
+		UID:         fmt.Sprintf("interview-%d@%s", interview.ID, configs.AppSettings.AppParams.ServerName),
+		Sequence:    interview.Sequence,
+		Summary:     fmt.Sprintf("Interview: %s at %s", vacancy.Title, vacancy.Company.Name),
+		Description: strings.Join(description, "\n"),
+		Location:    location,
+		URL:         interview.MeetingLink,
+		Start:       interview.StartsAt,
+		End:         interview.EndsAt,
+		Cancelled:   interview.Outcome == models.InterviewOutcomeCancelled,
+	}
+	return utils.GenerateICS(configs.AppSettings.AppParams.ServerName, event), nil
+}
+
+func getManagedInterview(userID uint, interviewID uint) (interview models.Interview, err error) {
+	if err = checkUserBlocked(userID); err != nil {
+		return models.Interview{}, err
+	}
+	interview, err = repository.GetInterviewByID(interviewID)
+	if err != nil {
+		if errors.Is(err, errs.ErrRecordNotFound) {
+			return models.Interview{}, errs.ErrInterviewNotFound
+		}
+		return models.Interview{}, err
+	}
+	if err = checkVacancyAccess(userID, interview.Application.Vacancy); err != nil {
+		return models.Interview{}, err
+	}
+	return interview, nil
+}
+
+// checkInterviewer allows the vacancy author and members of the vacancy's company to interview.
+func checkInterviewer(interviewerID uint, vacancy models.Vacancy) (err error) {
+	if interviewerID == vacancy.UserID {
+		return nil
+	}
+	if _, err = repository.GetCompanyMember(vacancy.CompanyID, interviewerID); err != nil {
+		if errors.Is(err, errs.ErrRecordNotFound) {
+			return errs.ErrInvalidInterviewer
+		}
+		return err
+	}
+	return nil
+}
+
+func validateInterviewSlot(interview models.Interview) (err error) {
+	if err = interview.ValidateInterview(); err != nil {
+		logger.Error.Printf("[service.validateInterviewSlot] validation error: %v\n", err)
+		return err
+	}
+	if interview.StartsAt.Before(time.Now()) {
+		return errs.ErrInvalidInterviewTime
+	}
+	return nil
+}
@@ -2,7 +2,9 @@ package service
 
 import (
 	"TajikCareerHub/configs"
+	"TajikCareerHub/pkg/repository"
 	"TajikCareerHub/utils/errs"
+	"errors"
 	"github.com/dgrijalva/jwt-go"
 	"github.com/gin-gonic/gin"
 	"os"
@@ -11,17 +13,19 @@ import (
 )
 
 type CustomClaims struct {
-	UserID   uint   `json:"user_id"`
-	Username string `json:"username"`
-	RoleID   uint   `json:"role_id"`
+	UserID       uint   `json:"user_id"`
+	Username     string `json:"username"`
+	RoleID       uint   `json:"role_id"`
+	TokenVersion uint   `json:"token_version"`
 	jwt.StandardClaims
 }
 
-func GenerateToken(userID uint, username string, roleID uint) (string, error) { // Изменяем параметры
+func GenerateToken(userID uint, username string, roleID uint, tokenVersion uint) (string, error) { // Изменяем параметры
 	claims := CustomClaims{
-		UserID:   userID,
-		Username: username,
-		RoleID:   roleID,
+		UserID:       userID,
+		Username:     username,
+		RoleID:       roleID,
+		TokenVersion: tokenVersion,
 		StandardClaims: jwt.StandardClaims{
 			ExpiresAt: time.Now().Add(time.Minute * time.Duration(configs.AppSettings.AuthParams.JwtTtlMinutes)).Unix(),
 			Issuer:    configs.AppSettings.AppParams.ServerName,
@@ -50,20 +54,24 @@ func ParseToken(tokenString string) (*CustomClaims, error) {
 	return nil, errs.ErrInvalidToken
 }
 
-func GetUserIDFromToken(c *gin.Context) (uint, error) {
-	tokenString := c.GetHeader("Authorization")
-	if tokenString == "" {
-		return 0, errs.ErrAuthorizationHeaderMissing
-	}
-	tokenString = strings.TrimPrefix(tokenString, "Bearer ")
-	claims, err := ParseToken(tokenString)
+func ValidateTokenClaims(claims *CustomClaims) error {
+	user, err := repository.GetUserByID(claims.UserID)
 	if err != nil {
-		return 0, err
+		if errors.Is(err, errs.ErrRecordNotFound) {
+			return errs.ErrTokenRevoked
+		}
+		return err
 	}
-	return claims.UserID, nil
+	if user.IsBlocked {
+		return errs.ErrUserBlocked
+	}
+	if user.TokenVersion != claims.TokenVersion {
+		return errs.ErrTokenRevoked
+	}
+	return nil
 }
 
-func GetRoleIDFromToken(c *gin.Context) (uint, error) {
+func GetUserIDFromToken(c *gin.Context) (uint, error) {
 	tokenString := c.GetHeader("Authorization")
 	if tokenString == "" {
 		return 0, errs.ErrAuthorizationHeaderMissing
@@ -73,7 +81,7 @@ func GetRoleIDFromToken(c *gin.Context) (uint, error) {
 	if err != nil {
 		return 0, err
 	}
-	return claims.RoleID, nil
+	return claims.UserID, nil
 }
 
 func GetUsernameFromToken(c *gin.Context) (string, error) {
@@ -0,0 +1,60 @@
+package service
+
+import (
+	"TajikCareerHub/models"
+	"TajikCareerHub/pkg/repository"
+	"TajikCareerHub/utils/errs"
+	"errors"
+	"strings"
+)
+
+func SearchLocations(query models.LocationQuery) ([]models.Location, error) {
+	query.Search = strings.TrimSpace(query.Search)
+	if query.Type != "" && !models.IsValidLocationType(query.Type) {
+		return nil, errs.ErrInvalidLocationType
+	}
+	if query.Limit <= 0 {
+		query.Limit = models.DefaultLocationSuggestions
+	}
+	if query.Limit > models.MaxLocationSuggestions {
+		query.Limit = models.MaxLocationSuggestions
+	}
+	return repository.SearchLocations(query)
+}
+
+func GetLocationByID(id uint) (location models.Location, err error) {
+	location, err = repository.GetLocationByID(id)
+	if err != nil {
+		if errors.Is(err, errs.ErrRecordNotFound) {
+			return models.Location{}, errs.ErrLocationNotFound
+		}
+		return models.Location{}, err
+	}
+	return location, nil
+}
+
+// resolveLocation links a location to the locations dictionary and returns the location ID
+// with the text to store. The ID wins over the text, otherwise the text is looked up by name
+// in any language. In both cases the text becomes the location's English name. Text that
+// names no location is kept as it is, unlinked.
+func resolveLocation(locationID *uint, text string) (*uint, string, error) {
+	if locationID != nil && *locationID != 0 {
+		location, err := GetLocationByID(*locationID)
+		if err != nil {
+			return nil, "", err
+		}
+		return &location.ID, location.NameEN, nil
+	}
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return nil, "", nil
+	}
+	location, err := repository.FindLocationByName(text)
+	if err != nil {
+		if errors.Is(err, errs.ErrRecordNotFound) {
+			return nil, text, nil
+		}
+		return nil, "", err
+	}
+	return &location.ID, location.NameEN, nil
+}
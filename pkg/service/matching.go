@@ -0,0 +1,321 @@
+package service
+
+import (
+	"TajikCareerHub/configs"
+	"TajikCareerHub/logger"
+	"TajikCareerHub/models"
+	"TajikCareerHub/pkg/repository"
+	"TajikCareerHub/utils/errs"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// GetVacancyCandidates ranks resumes for the vacancy. Available to the vacancy author and
+// members of its company.
+func GetVacancyCandidates(userID uint, vacancyID uint, minScore float64, params models.PageParams) (matches []models.Match, info models.PageInfo, err error) {
+	if err = checkUserBlocked(userID); err != nil {
+		return nil, info, err
+	}
+	vacancy, err := repository.GetVacancyByID(vacancyID)
+	if err != nil {
+		if errors.Is(err, errs.ErrRecordNotFound) {
+			return nil, info, errs.ErrVacancyNotFound
+		}
+		return nil, info, err
+	}
+	if err = checkVacancyAccess(userID, vacancy); err != nil {
+		return nil, info, err
+	}
+	if vacancy.IsBlocked {
+		return nil, info, errs.ErrVacancyBlocked
+	}
+
+	resumes, err := repository.GetCandidateResumes(vacancy, models.MatchCandidatesLimit)
+	if err != nil {
+		return nil, info, err
+	}
+	for i := range resumes {
+		match := scoreMatch(vacancy, resumes[i])
+		if match.Score >= minScore {
+			match.Resume = &resumes[i]
+			matches = append(matches, match)
+		}
+	}
+	logger.Info.Printf("[service.GetVacancyCandidates] Scored %d resumes for vacancy %d, %d matched\n", len(resumes), vacancyID, len(matches))
+	return pageMatches(matches, params)
+}
+
+// GetResumeRecommendations ranks vacancies for the resume. Available to the resume owner.
+func GetResumeRecommendations(userID uint, resumeID uint, minScore float64, params models.PageParams) (matches []models.Match, info models.PageInfo, err error) {
+	if err = checkUserBlocked(userID); err != nil {
+		return nil, info, err
+	}
+	resume, err := repository.GetResumeByID(resumeID)
+	if err != nil {
+		if errors.Is(err, errs.ErrRecordNotFound) {
+			return nil, info, errs.ErrResumeNotFound
+		}
+		return nil, info, err
+	}
+	if err = checkOwnership(userID, resume.UserID); err != nil {
+		return nil, info, err
+	}
+	if resume.IsBlocked {
+		return nil, info, errs.ErrResumeBlocked
+	}
+
+	vacancies, err := repository.GetCandidateVacancies(resume, models.MatchCandidatesLimit)
+	if err != nil {
+		return nil, info, err
+	}
+	viewer, err := getSalaryViewer(userID)
+	if err != nil {
+		return nil, info, err
+	}
+	for i := range vacancies {
+		match := scoreMatch(vacancies[i], resume)
+		if match.Score >= minScore {
+			hideSalary(viewer, &vacancies[i])
+			match.Vacancy = &vacancies[i]
+			matches = append(matches, match)
+		}
+	}
+	logger.Info.Printf("[service.GetResumeRecommendations] Scored %d vacancies for resume %d, %d matched\n", len(vacancies), resumeID, len(matches))
+	return pageMatches(matches, params)
+}
+
+// pageMatches sorts the matches by score, best first, and cuts the requested page.
+// Matches are computed in memory, so only page and size are supported.
+func pageMatches(matches []models.Match, params models.PageParams) ([]models.Match, models.PageInfo, error) {
+	if params.Cursor != "" {
+		return nil, models.PageInfo{}, errs.ErrInvalidCursor
+	}
+	if len(params.Sort) > 0 {
+		return nil, models.PageInfo{}, errs.ErrInvalidSortField
+	}
+	sort.SliceStable(matches, func(i, j int) bool { return matches[i].Score > matches[j].Score })
+
+	info := models.PageInfo{Total: int64(len(matches))}
+	start := (params.Page - 1) * params.Size
+	if start >= len(matches) {
+		return []models.Match{}, info, nil
+	}
+	end := start + params.Size
+	if end > len(matches) {
+		end = len(matches)
+	}
+	return matches[start:end], info, nil
+}
+
+// scoreMatch scores how well the resume fits the vacancy. The score is symmetric, so the
+// pair gets the same score in candidates and recommendations. Criteria the vacancy or
+// resume doesn't specify get half of their weight.
+func scoreMatch(vacancy models.Vacancy, resume models.Resume) models.Match {
+	breakdown := []models.ScoreComponent{
+		scoreCategory(vacancy, resume),
+		scoreLocation(vacancy, resume),
+		scoreSalary(vacancy, resume),
+		scoreExperience(vacancy, resume),
+		scoreSkills(vacancy, resume),
+		scoreText(vacancy, resume),
+	}
+	var total float64
+	for i := range breakdown {
+		breakdown[i].Score = roundScore(breakdown[i].Score)
+		total += breakdown[i].Score
+	}
+	return models.Match{Score: roundScore(total), Breakdown: breakdown}
+}
+
+func roundScore(score float64) float64 {
+	return math.Round(score*100) / 100
+}
+
+func unspecified(criterion string, weight float64, details string) models.ScoreComponent {
+	return models.ScoreComponent{Criterion: criterion, Weight: weight, Score: weight / 2, Details: details}
+}
+
+func scoreCategory(vacancy models.Vacancy, resume models.Resume) models.ScoreComponent {
+	component := models.ScoreComponent{Criterion: models.MatchCriterionCategory, Weight: models.MatchWeightCategory}
+	if vacancy.VacancyCategoryID == resume.VacancyCategoryID {
+		component.Score = component.Weight
+		component.Details = "same category"
+	} else {
+		component.Details = "different category"
+	}
+	return component
+}
+
+// scoreLocation gives the full weight when one location lies within the other, such as a
+// city in the vacancy's region, and half of it for places in the same region. Locations
+// outside the dictionary are compared by name.
+func scoreLocation(vacancy models.Vacancy, resume models.Resume) models.ScoreComponent {
+	vacancyLocation, resumeLocation := strings.TrimSpace(vacancy.Location), strings.TrimSpace(resume.Location)
+	if vacancyLocation == "" || resumeLocation == "" {
+		return unspecified(models.MatchCriterionLocation, models.MatchWeightLocation, "location not specified")
+	}
+	component := models.ScoreComponent{Criterion: models.MatchCriterionLocation, Weight: models.MatchWeightLocation}
+	if vacancy.LocationDetails != nil && resume.LocationDetails != nil {
+		vacancyPath, resumePath := vacancy.LocationDetails.Path(), resume.LocationDetails.Path()
+		switch {
+		case containsID(vacancyPath, resumePath[0]) || containsID(resumePath, vacancyPath[0]):
+			component.Score = component.Weight
+			component.Details = "same location"
+		case vacancyPath[len(vacancyPath)-1] == resumePath[len(resumePath)-1]:
+			component.Score = component.Weight / 2
+			component.Details = fmt.Sprintf("vacancy in %s, candidate in %s of the same region", vacancyLocation, resumeLocation)
+		default:
+			component.Details = fmt.Sprintf("vacancy in %s, candidate in %s", vacancyLocation, resumeLocation)
+		}
+		return component
+	}
+	if strings.EqualFold(vacancyLocation, resumeLocation) {
+		component.Score = component.Weight
+		component.Details = "same location"
+	} else {
+		component.Details = fmt.Sprintf("vacancy in %s, candidate in %s", vacancyLocation, resumeLocation)
+	}
+	return component
+}
+
+func containsID(ids []uint, id uint) bool {
+	for _, v := range ids {
+		if v == id {
+			return true
+		}
+	}
+	return false
+}
+
+// scoreSalary gives the full weight when the expectation fits the offered salary and
+// nothing when it exceeds the offer by half or more. Both are compared as monthly amounts
+// in the base currency.
+func scoreSalary(vacancy models.Vacancy, resume models.Resume) models.ScoreComponent {
+	rates := configs.AppSettings.ExchangeRates
+	resumeCurrency := resume.ExpectedSalaryCurrency
+	if resumeCurrency == "" {
+		resumeCurrency = models.CurrencyTJS
+	}
+	offer, offerOK := rates.ToMonthlyBase(vacancy.SalaryUpperBound(), vacancy.Currency, vacancy.SalaryPeriod)
+	expected, expectedOK := rates.ToMonthlyBase(resume.ExpectedSalary, resumeCurrency, models.SalaryPeriodMonth)
+	if !offerOK || !expectedOK || offer <= 0 || expected <= 0 {
+		return unspecified(models.MatchCriterionSalary, models.MatchWeightSalary, "salary not specified")
+	}
+	component := models.ScoreComponent{Criterion: models.MatchCriterionSalary, Weight: models.MatchWeightSalary}
+	if expected <= offer {
+		component.Score = component.Weight
+		component.Details = "expected salary within the offer"
+		return component
+	}
+	excess := (expected - offer) / offer
+	component.Score = component.Weight * math.Max(0, 1-excess/0.5)
+	if vacancy.SalaryHidden {
+		component.Details = "expected salary exceeds the offer"
+	} else {
+		component.Details = fmt.Sprintf("expected salary exceeds the offer by %.0f%%", excess*100)
+	}
+	return component
+}
+
+// scoreExperience gives the full weight when the candidate's years fall within the
+// vacancy's range. Below the minimum the score shrinks in proportion to the missing years,
+// above the maximum in proportion to the years over it, as overqualified candidates fit too.
+func scoreExperience(vacancy models.Vacancy, resume models.Resume) models.ScoreComponent {
+	if !vacancy.HasExperience() {
+		return unspecified(models.MatchCriterionExperience, models.MatchWeightExperience, "experience not specified")
+	}
+	component := models.ScoreComponent{Criterion: models.MatchCriterionExperience, Weight: models.MatchWeightExperience}
+	years, minYears, maxYears := resume.ExperienceYears, vacancy.MinExperienceYears, vacancy.MaxExperienceYears
+	required := fmt.Sprintf("%d+", minYears)
+	if maxYears != nil && *maxYears == minYears {
+		required = fmt.Sprint(minYears)
+	} else if maxYears != nil {
+		required = fmt.Sprintf("%d-%d", minYears, *maxYears)
+	}
+	component.Details = fmt.Sprintf("has %d, needs %s years", years, required)
+	switch {
+	case years < minYears:
+		component.Score = component.Weight * float64(years) / float64(minYears)
+	case maxYears != nil && years > *maxYears:
+		component.Score = component.Weight * float64(*maxYears+1) / float64(years+1)
+	default:
+		component.Score = component.Weight
+	}
+	return component
+}
+
+// scoreSkills scores the share of the vacancy's skills found in the resume.
+func scoreSkills(vacancy models.Vacancy, resume models.Resume) models.ScoreComponent {
+	if len(vacancy.Skills) == 0 {
+		return unspecified(models.MatchCriterionSkills, models.MatchWeightSkills, "vacancy lists no skills")
+	}
+	resumeSkillIDs := make(map[uint]bool, len(resume.SkillTags))
+	resumeSkillNames := make(map[string]bool, len(resume.SkillTags))
+	for _, tag := range resume.SkillTags {
+		if tag.SkillID != nil {
+			resumeSkillIDs[*tag.SkillID] = true
+		}
+		resumeSkillNames[tag.Name] = true
+	}
+	var matched, missing []string
+	for _, skill := range vacancy.Skills {
+		if resumeSkillIDs[skill.ID] || resumeSkillNames[skill.Name] {
+			matched = append(matched, skill.Name)
+		} else {
+			missing = append(missing, skill.Name)
+		}
+	}
+	component := models.ScoreComponent{Criterion: models.MatchCriterionSkills, Weight: models.MatchWeightSkills}
+	component.Score = component.Weight * float64(len(matched)) / float64(len(vacancy.Skills))
+	component.Details = fmt.Sprintf("%d of %d skills", len(matched), len(vacancy.Skills))
+	if len(matched) > 0 {
+		component.Details += "; matched: " + strings.Join(matched, ", ")
+	}
+	if len(missing) > 0 {
+		component.Details += "; missing: " + strings.Join(missing, ", ")
+	}
+	return component
+}
+
+// scoreText scores the cosine similarity of the words used in the vacancy and the resume.
+func scoreText(vacancy models.Vacancy, resume models.Resume) models.ScoreComponent {
+	vacancyTerms := termFrequencies(vacancy.Title + " " + vacancy.Description)
+	resumeTerms := termFrequencies(strings.Join([]string{resume.Title, resume.Summary, resume.Skills}, " "))
+	if len(vacancyTerms) == 0 || len(resumeTerms) == 0 {
+		return unspecified(models.MatchCriterionText, models.MatchWeightText, "no text to compare")
+	}
+	var dot, vacancyNorm, resumeNorm float64
+	for term, count := range vacancyTerms {
+		dot += count * resumeTerms[term]
+		vacancyNorm += count * count
+	}
+	for _, count := range resumeTerms {
+		resumeNorm += count * count
+	}
+	similarity := dot / (math.Sqrt(vacancyNorm) * math.Sqrt(resumeNorm))
+	return models.ScoreComponent{
+		Criterion: models.MatchCriterionText,
+		Weight:    models.MatchWeightText,
+		Score:     models.MatchWeightText * similarity,
+		Details:   fmt.Sprintf("text similarity %.0f%%", similarity*100),
+	}
+}
+
+// termFrequencies counts the lowercased words of at least three letters in the text.
+func termFrequencies(text string) map[string]float64 {
+	terms := make(map[string]float64)
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	for _, word := range words {
+		if utf8.RuneCountInString(word) >= 3 {
+			terms[word]++
+		}
+	}
+	return terms
+}
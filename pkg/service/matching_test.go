@@ -0,0 +1,339 @@
+package service
+
+import (
+	"TajikCareerHub/configs"
+	"TajikCareerHub/models"
+	"testing"
+)
+
+var testRates = models.ExchangeRates{
+	BaseCurrency:  models.CurrencyTJS,
+	Rates:         map[string]float64{models.CurrencyUSD: 10},
+	HoursPerMonth: 160,
+}
+
+// useTestRates sets the exchange rates scoreSalary reads from the configuration for the
+// duration of the test.
+func useTestRates(t *testing.T) {
+	t.Helper()
+	previous := configs.AppSettings.ExchangeRates
+	configs.AppSettings.ExchangeRates = testRates
+	t.Cleanup(func() { configs.AppSettings.ExchangeRates = previous })
+}
+
+func uintPtr(v uint) *uint {
+	return &v
+}
+
+// Dushanbe and Vahdat are cities of one region, Khujand lies in another one.
+var (
+	regionRRS    = &models.Location{ID: 1}
+	regionSughd  = &models.Location{ID: 2}
+	cityDushanbe = &models.Location{ID: 10, ParentID: uintPtr(1), Parent: regionRRS}
+	cityVahdat   = &models.Location{ID: 11, ParentID: uintPtr(1), Parent: regionRRS}
+	cityKhujand  = &models.Location{ID: 20, ParentID: uintPtr(2), Parent: regionSughd}
+)
+
+func TestScoreMatch(t *testing.T) {
+	useTestRates(t)
+	goSkill := models.Skill{ID: 1, Name: "go"}
+	sqlSkill := models.Skill{ID: 2, Name: "sql"}
+	vacancy := models.Vacancy{
+		Title:              "Backend developer",
+		Description:        "Backend services",
+		Location:           "Dushanbe",
+		VacancyCategoryID:  1,
+		SalaryMin:          8000,
+		SalaryMax:          10000,
+		Currency:           models.CurrencyTJS,
+		SalaryPeriod:       models.SalaryPeriodMonth,
+		MinExperienceYears: 2,
+		Skills:             []models.Skill{goSkill, sqlSkill},
+	}
+	perfect := models.Resume{
+		Title:             "Backend developer",
+		Summary:           "Backend services",
+		Location:          "dushanbe",
+		VacancyCategoryID: 1,
+		ExpectedSalary:    9000,
+		ExperienceYears:   3,
+		SkillTags:         []models.ResumeSkill{{Name: "go", SkillID: uintPtr(1)}, {Name: "sql"}},
+	}
+	mismatch := models.Resume{
+		Title:                  "Accountant",
+		Summary:                "Financial reports",
+		Location:               "Khujand",
+		VacancyCategoryID:      2,
+		ExpectedSalary:         1500,
+		ExpectedSalaryCurrency: models.CurrencyUSD,
+		SkillTags:              []models.ResumeSkill{{Name: "excel"}},
+	}
+
+	tests := []struct {
+		name    string
+		vacancy models.Vacancy
+		resume  models.Resume
+		want    float64
+	}{
+		{"everything matches", vacancy, perfect, 100},
+		{"nothing matches", vacancy, mismatch, 0},
+		// Only the categories match, the rest isn't specified and gets half of its weight.
+		{"nothing specified", models.Vacancy{VacancyCategoryID: 1}, models.Resume{VacancyCategoryID: 1}, 20 + 7.5 + 7.5 + 7.5 + 12.5 + 5},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			match := scoreMatch(tt.vacancy, tt.resume)
+			if match.Score != tt.want {
+				t.Errorf("score = %v, want %v, breakdown %+v", match.Score, tt.want, match.Breakdown)
+			}
+			criteria := []string{models.MatchCriterionCategory, models.MatchCriterionLocation, models.MatchCriterionSalary,
+				models.MatchCriterionExperience, models.MatchCriterionSkills, models.MatchCriterionText}
+			if len(match.Breakdown) != len(criteria) {
+				t.Fatalf("breakdown has %d components, want %d", len(match.Breakdown), len(criteria))
+			}
+			var weights, scores float64
+			for i, component := range match.Breakdown {
+				if component.Criterion != criteria[i] {
+					t.Errorf("component %d is %q, want %q", i, component.Criterion, criteria[i])
+				}
+				if component.Score < 0 || component.Score > component.Weight {
+					t.Errorf("%s scored %v of %v", component.Criterion, component.Score, component.Weight)
+				}
+				weights += component.Weight
+				scores += component.Score
+			}
+			if weights != 100 {
+				t.Errorf("weights add up to %v, want 100", weights)
+			}
+			if roundScore(scores) != match.Score {
+				t.Errorf("components add up to %v, score is %v", scores, match.Score)
+			}
+		})
+	}
+}
+
+func TestScoreLocation(t *testing.T) {
+	tests := []struct {
+		name    string
+		vacancy models.Vacancy
+		resume  models.Resume
+		want    float64
+		details string
+	}{
+		{"not specified", models.Vacancy{Location: "Dushanbe"}, models.Resume{}, 7.5, "location not specified"},
+		{"same name", models.Vacancy{Location: "Dushanbe"}, models.Resume{Location: " DUSHANBE "}, 15, "same location"},
+		{"different names", models.Vacancy{Location: "Dushanbe"}, models.Resume{Location: "Khujand"}, 0, "vacancy in Dushanbe, candidate in Khujand"},
+		{
+			name:    "same city",
+			vacancy: models.Vacancy{Location: "Dushanbe", LocationDetails: cityDushanbe},
+			resume:  models.Resume{Location: "Душанбе", LocationDetails: cityDushanbe},
+			want:    15,
+			details: "same location",
+		},
+		{
+			name:    "city in the vacancy's region",
+			vacancy: models.Vacancy{Location: "RRS", LocationDetails: regionRRS},
+			resume:  models.Resume{Location: "Vahdat", LocationDetails: cityVahdat},
+			want:    15,
+			details: "same location",
+		},
+		{
+			name:    "region of the vacancy's city",
+			vacancy: models.Vacancy{Location: "Vahdat", LocationDetails: cityVahdat},
+			resume:  models.Resume{Location: "RRS", LocationDetails: regionRRS},
+			want:    15,
+			details: "same location",
+		},
+		{
+			name:    "cities of the same region",
+			vacancy: models.Vacancy{Location: "Dushanbe", LocationDetails: cityDushanbe},
+			resume:  models.Resume{Location: "Vahdat", LocationDetails: cityVahdat},
+			want:    7.5,
+			details: "vacancy in Dushanbe, candidate in Vahdat of the same region",
+		},
+		{
+			name:    "different regions",
+			vacancy: models.Vacancy{Location: "Dushanbe", LocationDetails: cityDushanbe},
+			resume:  models.Resume{Location: "Khujand", LocationDetails: cityKhujand},
+			want:    0,
+			details: "vacancy in Dushanbe, candidate in Khujand",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := scoreLocation(tt.vacancy, tt.resume)
+			if got.Criterion != models.MatchCriterionLocation || got.Weight != models.MatchWeightLocation {
+				t.Errorf("component is %q with weight %v", got.Criterion, got.Weight)
+			}
+			if got.Score != tt.want || got.Details != tt.details {
+				t.Errorf("scoreLocation() = %v %q, want %v %q", got.Score, got.Details, tt.want, tt.details)
+			}
+		})
+	}
+}
+
+func TestScoreSalary(t *testing.T) {
+	useTestRates(t)
+	monthly := func(min, max float64) models.Vacancy {
+		return models.Vacancy{SalaryMin: min, SalaryMax: max, Currency: models.CurrencyTJS, SalaryPeriod: models.SalaryPeriodMonth}
+	}
+	hidden := monthly(0, 10000)
+	hidden.SalaryHidden = true
+	tests := []struct {
+		name    string
+		vacancy models.Vacancy
+		resume  models.Resume
+		want    float64
+		details string
+	}{
+		{"within the offer", monthly(8000, 10000), models.Resume{ExpectedSalary: 10000}, 15, "expected salary within the offer"},
+		{"from salary", monthly(8000, 0), models.Resume{ExpectedSalary: 8000}, 15, "expected salary within the offer"},
+		{"exceeds by a quarter", monthly(0, 10000), models.Resume{ExpectedSalary: 12500}, 7.5, "expected salary exceeds the offer by 25%"},
+		{"exceeds by half", monthly(0, 10000), models.Resume{ExpectedSalary: 15000}, 0, "expected salary exceeds the offer by 50%"},
+		{"exceeds twice", monthly(0, 10000), models.Resume{ExpectedSalary: 20000}, 0, "expected salary exceeds the offer by 100%"},
+		{"hidden salary", hidden, models.Resume{ExpectedSalary: 12500}, 7.5, "expected salary exceeds the offer"},
+		{"expectation in another currency", monthly(0, 10000), models.Resume{ExpectedSalary: 1000, ExpectedSalaryCurrency: models.CurrencyUSD}, 15, "expected salary within the offer"},
+		{
+			name:    "hourly offer",
+			vacancy: models.Vacancy{SalaryMin: 50, Currency: models.CurrencyTJS, SalaryPeriod: models.SalaryPeriodHour},
+			resume:  models.Resume{ExpectedSalary: 8000},
+			want:    15,
+			details: "expected salary within the offer",
+		},
+		{"negotiable offer", models.Vacancy{Currency: models.CurrencyTJS, SalaryNegotiable: true}, models.Resume{ExpectedSalary: 8000}, 7.5, "salary not specified"},
+		{"no expectation", monthly(0, 10000), models.Resume{}, 7.5, "salary not specified"},
+		{"currency without a rate", monthly(0, 10000), models.Resume{ExpectedSalary: 100, ExpectedSalaryCurrency: models.CurrencyRUB}, 7.5, "salary not specified"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := scoreSalary(tt.vacancy, tt.resume)
+			if got.Criterion != models.MatchCriterionSalary || got.Weight != models.MatchWeightSalary {
+				t.Errorf("component is %q with weight %v", got.Criterion, got.Weight)
+			}
+			if roundScore(got.Score) != tt.want || got.Details != tt.details {
+				t.Errorf("scoreSalary() = %v %q, want %v %q", got.Score, got.Details, tt.want, tt.details)
+			}
+		})
+	}
+}
+
+func TestScoreExperience(t *testing.T) {
+	tests := []struct {
+		name    string
+		min     uint
+		max     *uint
+		years   uint
+		want    float64
+		details string
+	}{
+		{"not specified", 0, nil, 5, 7.5, "experience not specified"},
+		{"none required", 0, uintPtr(0), 0, 15, "has 0, needs 0 years"},
+		{"up to a maximum", 0, uintPtr(2), 1, 15, "has 1, needs 0-2 years"},
+		{"enough", 3, nil, 3, 15, "has 3, needs 3+ years"},
+		{"more than the minimum", 2, nil, 10, 15, "has 10, needs 2+ years"},
+		{"within the range", 2, uintPtr(5), 5, 15, "has 5, needs 2-5 years"},
+		{"part of the minimum", 4, nil, 1, 3.75, "has 1, needs 4+ years"},
+		{"none of the minimum", 4, uintPtr(6), 0, 0, "has 0, needs 4-6 years"},
+		{"over the maximum", 2, uintPtr(5), 11, 7.5, "has 11, needs 2-5 years"},
+		{"experience for a trainee position", 0, uintPtr(0), 2, 5, "has 2, needs 0 years"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vacancy := models.Vacancy{MinExperienceYears: tt.min, MaxExperienceYears: tt.max}
+			got := scoreExperience(vacancy, models.Resume{ExperienceYears: tt.years})
+			if got.Criterion != models.MatchCriterionExperience || got.Weight != models.MatchWeightExperience {
+				t.Errorf("component is %q with weight %v", got.Criterion, got.Weight)
+			}
+			if got.Score != tt.want || got.Details != tt.details {
+				t.Errorf("scoreExperience() = %v %q, want %v %q", got.Score, got.Details, tt.want, tt.details)
+			}
+		})
+	}
+}
+
+func TestScoreSkills(t *testing.T) {
+	skills := []models.Skill{{ID: 1, Name: "go"}, {ID: 2, Name: "postgresql"}, {ID: 3, Name: "docker"}, {ID: 4, Name: "kubernetes"}}
+	tests := []struct {
+		name    string
+		vacancy []models.Skill
+		resume  []models.ResumeSkill
+		want    float64
+		details string
+	}{
+		{"vacancy lists no skills", nil, []models.ResumeSkill{{Name: "go"}}, 12.5, "vacancy lists no skills"},
+		{
+			name:    "all matched by link or name",
+			vacancy: skills[:2],
+			resume:  []models.ResumeSkill{{Name: "golang", SkillID: uintPtr(1)}, {Name: "postgresql"}},
+			want:    25,
+			details: "2 of 2 skills; matched: go, postgresql",
+		},
+		{
+			name:    "some matched",
+			vacancy: skills,
+			resume:  []models.ResumeSkill{{Name: "go", SkillID: uintPtr(1)}},
+			want:    6.25,
+			details: "1 of 4 skills; matched: go; missing: postgresql, docker, kubernetes",
+		},
+		{
+			name:    "none matched",
+			vacancy: skills[:1],
+			resume:  []models.ResumeSkill{{Name: "excel"}},
+			want:    0,
+			details: "0 of 1 skills; missing: go",
+		},
+		{"resume without skills", skills[:2], nil, 0, "0 of 2 skills; missing: go, postgresql"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := scoreSkills(models.Vacancy{Skills: tt.vacancy}, models.Resume{SkillTags: tt.resume})
+			if got.Criterion != models.MatchCriterionSkills || got.Weight != models.MatchWeightSkills {
+				t.Errorf("component is %q with weight %v", got.Criterion, got.Weight)
+			}
+			if got.Score != tt.want || got.Details != tt.details {
+				t.Errorf("scoreSkills() = %v %q, want %v %q", got.Score, got.Details, tt.want, tt.details)
+			}
+		})
+	}
+}
+
+func TestScoreText(t *testing.T) {
+	tests := []struct {
+		name    string
+		vacancy models.Vacancy
+		resume  models.Resume
+		want    float64
+	}{
+		{"no text", models.Vacancy{Title: "Go"}, models.Resume{Title: "Backend developer"}, 5},
+		{"same words", models.Vacancy{Title: "Backend developer"}, models.Resume{Title: "backend", Summary: "DEVELOPER"}, 10},
+		{"no common words", models.Vacancy{Title: "Backend developer"}, models.Resume{Title: "Chief accountant"}, 0},
+		{"half of the words", models.Vacancy{Title: "Backend developer"}, models.Resume{Title: "Backend engineer"}, 5},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := scoreText(tt.vacancy, tt.resume)
+			if got.Criterion != models.MatchCriterionText || got.Weight != models.MatchWeightText {
+				t.Errorf("component is %q with weight %v", got.Criterion, got.Weight)
+			}
+			if roundScore(got.Score) != tt.want {
+				t.Errorf("scoreText() = %v (%s), want %v", got.Score, got.Details, tt.want)
+			}
+		})
+	}
+}
+
+func TestTermFrequencies(t *testing.T) {
+	got := termFrequencies("Go, Golang и PostgreSQL; golang-разработчик 2024")
+	want := map[string]float64{"golang": 2, "postgresql": 1, "разработчик": 1, "2024": 1}
+	if len(got) != len(want) {
+		t.Fatalf("termFrequencies() = %v, want %v", got, want)
+	}
+	for term, count := range want {
+		if got[term] != count {
+			t.Errorf("%q counted %v times, want %v", term, got[term], count)
+		}
+	}
+	if _, ok := got["go"]; ok {
+		t.Error("words shorter than three letters are counted")
+	}
+}
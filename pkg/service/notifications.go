@@ -0,0 +1,20 @@
+package service
+
+import (
+	"TajikCareerHub/models"
+	"TajikCareerHub/pkg/repository"
+)
+
+func GetMyNotifications(userID uint, unreadOnly bool, params models.PageParams) ([]models.Notification, models.PageInfo, error) {
+	if err := checkUserBlocked(userID); err != nil {
+		return nil, models.PageInfo{}, err
+	}
+	return repository.GetNotificationsByUser(userID, unreadOnly, params)
+}
+
+func MarkNotificationRead(userID uint, notificationID uint) (err error) {
+	if err = checkUserBlocked(userID); err != nil {
+		return err
+	}
+	return repository.MarkNotificationRead(userID, notificationID)
+}
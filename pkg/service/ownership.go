@@ -0,0 +1,41 @@
+package service
+
+import (
+	"TajikCareerHub/logger"
+	"TajikCareerHub/models"
+	"TajikCareerHub/pkg/repository"
+	"TajikCareerHub/utils/errs"
+	"errors"
+)
+
+func canManageAnyResource(userID uint) (bool, error) {
+	user, err := repository.GetUserByID(userID)
+	if err != nil {
+		return false, err
+	}
+	err = CheckPermission(user.RoleID, models.PermissionManageAnyResource)
+	if err != nil {
+		if errors.Is(err, errs.ErrAccessDenied) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+func checkOwnership(userID uint, ownerIDs ...uint) (err error) {
+	for _, ownerID := range ownerIDs {
+		if ownerID != 0 && ownerID == userID {
+			return nil
+		}
+	}
+	isAdmin, err := canManageAnyResource(userID)
+	if err != nil {
+		return err
+	}
+	if !isAdmin {
+		logger.Warning.Printf("[service.checkOwnership] User with ID %d is not allowed to manage resource owned by %v\n", userID, ownerIDs)
+		return errs.ErrAccessDenied
+	}
+	return nil
+}
@@ -0,0 +1,188 @@
+package service
+
+import (
+	"TajikCareerHub/db"
+	"TajikCareerHub/models"
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"regexp"
+	"strings"
+	"testing"
+
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// fakeColumns are returned by every query of the fake database. They cover the keys the
+// listings preload by, so each preload finds rows and runs its nested preloads.
+var fakeColumns = []string{"id", "user_id", "company_id", "vacancy_id", "resume_id", "skill_id",
+	"vacancy_category_id", "status_id", "location_id", "application_id"}
+
+var singleColumn = regexp.MustCompile(`^SELECT "?(\w+)"? FROM`)
+
+// fakeDB answers the listing queries without a server: the page query gets rows rows, a
+// count gets rows, and every other query, such as a preload, gets a row per argument. The
+// user has the permission to manage any resource only when admin is set.
+type fakeDB struct {
+	rows  int
+	admin bool
+}
+
+func (f *fakeDB) Connect(context.Context) (driver.Conn, error) { return fakeConn{f}, nil }
+func (f *fakeDB) Driver() driver.Driver                        { return nil }
+
+type fakeConn struct {
+	db *fakeDB
+}
+
+func (c fakeConn) Prepare(string) (driver.Stmt, error) { return nil, errors.New("not supported") }
+func (c fakeConn) Close() error                        { return nil }
+func (c fakeConn) Begin() (driver.Tx, error)           { return nil, errors.New("not supported") }
+
+func (c fakeConn) QueryContext(_ context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	if strings.Contains(query, "role_permissions") {
+		count := int64(0)
+		if c.db.admin {
+			count = 1
+		}
+		return &fakeRows{columns: []string{"count"}, values: [][]driver.Value{{count}}}, nil
+	}
+	if strings.Contains(strings.ToLower(query), "count(") {
+		return &fakeRows{columns: []string{"count"}, values: [][]driver.Value{{int64(c.db.rows)}}}, nil
+	}
+	rows := &fakeRows{columns: fakeColumns}
+	// A pluck selects a single column.
+	if m := singleColumn.FindStringSubmatch(query); m != nil {
+		rows.columns = []string{m[1]}
+	}
+	addRow := func(id int64) {
+		row := make([]driver.Value, len(rows.columns))
+		for i := range row {
+			row[i] = id
+		}
+		rows.values = append(rows.values, row)
+	}
+	if strings.Contains(query, "LIMIT") {
+		for id := 1; id <= c.db.rows; id++ {
+			addRow(int64(id))
+		}
+		return rows, nil
+	}
+	for _, arg := range args {
+		if id, ok := arg.Value.(int64); ok {
+			addRow(id)
+		}
+	}
+	return rows, nil
+}
+
+type fakeRows struct {
+	columns []string
+	values  [][]driver.Value
+	next    int
+}
+
+func (r *fakeRows) Columns() []string { return r.columns }
+func (r *fakeRows) Close() error      { return nil }
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if r.next >= len(r.values) {
+		return io.EOF
+	}
+	copy(dest, r.values[r.next])
+	r.next++
+	return nil
+}
+
+// countQueries runs list against a fake database holding rows rows and returns the number
+// of statements it executed.
+func countQueries(t *testing.T, rows int, admin bool, list func() error) int {
+	t.Helper()
+	conn, err := gorm.Open(postgres.New(postgres.Config{Conn: sql.OpenDB(&fakeDB{rows: rows, admin: admin})}), &gorm.Config{
+		DisableAutomaticPing: true,
+		Logger:               logger.Discard,
+	})
+	if err != nil {
+		t.Fatalf("opening fake database: %v", err)
+	}
+	queries := 0
+	err = conn.Callback().Query().After("gorm:query").Register("test:count_queries", func(*gorm.DB) {
+		queries++
+	})
+	if err != nil {
+		t.Fatalf("registering callback: %v", err)
+	}
+
+	previous := db.GetDBConn()
+	db.SetDBConn(conn)
+	defer db.SetDBConn(previous)
+	if err = list(); err != nil {
+		t.Fatalf("listing %d rows: %v", rows, err)
+	}
+	return queries
+}
+
+// TestListingsQueryCount makes sure the listings run the same number of queries however
+// many rows they return, so that no check or preload runs per row. The fake user is the
+// author of every vacancy.
+func TestListingsQueryCount(t *testing.T) {
+	discardLogs(t)
+	useTestRates(t)
+	params := models.PageParams{Page: 1, Size: models.MaxPageSize}
+	tests := []struct {
+		name  string
+		admin bool
+		list  func() error
+	}{
+		{"vacancies", false, func() error {
+			_, _, err := GetAllVacancies(1, models.VacancyFilter{}, params)
+			return err
+		}},
+		{"vacancies by salary", false, func() error {
+			_, _, err := GetAllVacancies(1, models.VacancyFilter{MinSalary: 1000, SalaryCurrency: models.CurrencyUSD}, params)
+			return err
+		}},
+		{"vacancies for an admin", true, func() error {
+			_, _, err := GetAllVacancies(1, models.VacancyFilter{}, params)
+			return err
+		}},
+		{"resumes", false, func() error {
+			_, _, err := GetAllResumes(models.ResumeFilter{}, 1, params)
+			return err
+		}},
+		{"all applications", true, func() error {
+			_, _, err := GetAllApplications(1, params)
+			return err
+		}},
+		{"received applications", false, func() error {
+			_, _, err := GetReceivedApplications(1, models.ApplicationFilter{}, params)
+			return err
+		}},
+		{"vacancy applications", false, func() error {
+			_, _, err := GetVacancyApplications(1, 1, models.ApplicationFilter{}, params)
+			return err
+		}},
+		{"my applications", false, func() error {
+			_, _, err := GetMyApplications(1, "", params)
+			return err
+		}},
+	}
+
+	const rows = 5
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			few := countQueries(t, rows, tt.admin, tt.list)
+			many := countQueries(t, 10*rows, tt.admin, tt.list)
+			if few == 0 {
+				t.Fatal("no queries were counted")
+			}
+			if few != many {
+				t.Errorf("listing %d rows ran %d queries, listing %d rows ran %d", rows, few, 10*rows, many)
+			}
+		})
+	}
+}
@@ -0,0 +1,124 @@
+package service
+
+import (
+	"TajikCareerHub/configs"
+	"TajikCareerHub/logger"
+	"TajikCareerHub/models"
+	"TajikCareerHub/pkg/repository"
+	"TajikCareerHub/utils"
+	"TajikCareerHub/utils/errs"
+	"errors"
+	"time"
+)
+
+const (
+	refreshTokenSize = 32
+	familyIDSize     = 16
+)
+
+func issueRefreshToken(userID uint, familyID string) (refreshToken string, token models.RefreshToken, err error) {
+	refreshToken, err = utils.GenerateRandomToken(refreshTokenSize)
+	if err != nil {
+		return "", models.RefreshToken{}, err
+	}
+	token = models.RefreshToken{
+		UserID:    userID,
+		TokenHash: utils.GenerateHash(refreshToken),
+		FamilyID:  familyID,
+		ExpiresAt: time.Now().Add(time.Hour * time.Duration(configs.AppSettings.AuthParams.RefreshTtlHours)),
+	}
+	return refreshToken, token, nil
+}
+
+func CreateRefreshToken(userID uint) (refreshToken string, err error) {
+	familyID, err := utils.GenerateRandomToken(familyIDSize)
+	if err != nil {
+		logger.Error.Printf("[service.CreateRefreshToken] Error generating family ID: %v\n", err)
+		return "", err
+	}
+	refreshToken, token, err := issueRefreshToken(userID, familyID)
+	if err != nil {
+		logger.Error.Printf("[service.CreateRefreshToken] Error generating refresh token: %v\n", err)
+		return "", err
+	}
+	if err = repository.CreateRefreshToken(token); err != nil {
+		return "", err
+	}
+	return refreshToken, nil
+}
+
+func RefreshTokens(refreshToken string) (accessToken string, newRefreshToken string, err error) {
+	token, err := repository.GetRefreshTokenByHash(utils.GenerateHash(refreshToken))
+	if err != nil {
+		if errors.Is(err, errs.ErrRecordNotFound) {
+			return "", "", errs.ErrInvalidToken
+		}
+		return "", "", err
+	}
+
+	if token.RevokedAt != nil {
+		logger.Warning.Printf("[service.RefreshTokens] Reuse of revoked refresh token detected, revoking family %s of user ID %d\n", token.FamilyID, token.UserID)
+		if err = repository.RevokeRefreshTokenFamily(token.FamilyID); err != nil {
+			return "", "", err
+		}
+		return "", "", errs.ErrRefreshTokenReused
+	}
+	if time.Now().After(token.ExpiresAt) {
+		return "", "", errs.ErrRefreshTokenExpired
+	}
+
+	user, err := repository.GetUserByID(token.UserID)
+	if err != nil {
+		return "", "", err
+	}
+	if user.IsBlocked {
+		return "", "", errs.ErrUserBlocked
+	}
+
+	newRefreshToken, newToken, err := issueRefreshToken(user.ID, token.FamilyID)
+	if err != nil {
+		logger.Error.Printf("[service.RefreshTokens] Error generating refresh token: %v\n", err)
+		return "", "", err
+	}
+	if err = repository.RotateRefreshToken(token.ID, newToken); err != nil {
+		if errors.Is(err, errs.ErrRefreshTokenReused) {
+			if err := repository.RevokeRefreshTokenFamily(token.FamilyID); err != nil {
+				return "", "", err
+			}
+		}
+		return "", "", err
+	}
+
+	accessToken, err = GenerateToken(user.ID, user.UserName, user.RoleID, user.TokenVersion)
+	if err != nil {
+		logger.Error.Printf("[service.RefreshTokens] Error generating access token: %v\n", err)
+		return "", "", err
+	}
+	return accessToken, newRefreshToken, nil
+}
+
+func SignOut(refreshToken string) (err error) {
+	token, err := repository.GetRefreshTokenByHash(utils.GenerateHash(refreshToken))
+	if err != nil {
+		if errors.Is(err, errs.ErrRecordNotFound) {
+			return errs.ErrInvalidToken
+		}
+		return err
+	}
+	return repository.RevokeRefreshTokenFamily(token.FamilyID)
+}
+
+func RevokeUserSessions(id uint) (err error) {
+	if id == 0 {
+		logger.Error.Printf("[service.RevokeUserSessions] Invalid ID: %v", id)
+		return errs.ErrIDIsNotCorrect
+	}
+	return invalidateUserSessions(id)
+}
+
+func invalidateUserSessions(userID uint) (err error) {
+	if err = repository.IncrementTokenVersion(userID); err != nil {
+		return err
+	}
+	return repository.RevokeUserRefreshTokens(userID)
+}
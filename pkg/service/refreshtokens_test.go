@@ -0,0 +1,380 @@
+package service
+
+import (
+	"TajikCareerHub/configs"
+	"TajikCareerHub/db"
+	"TajikCareerHub/logger"
+	"TajikCareerHub/models"
+	"TajikCareerHub/pkg/repository"
+	"TajikCareerHub/utils"
+	"TajikCareerHub/utils/errs"
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	gormlogger "gorm.io/gorm/logger"
+)
+
+var refreshTokenColumns = []string{"id", "user_id", "token_hash", "family_id", "expires_at", "revoked_at",
+	"replaced_by_id", "created_at", "updated_at", "deleted_at"}
+
+var (
+	insertPattern    = regexp.MustCompile(`^INSERT INTO "refresh_tokens" \((.+?)\) VALUES`)
+	setPattern       = regexp.MustCompile(`"(\w+)"=\$(\d+)`)
+	argCondPattern   = regexp.MustCompile(`^(\w+) = \$(\d+)$`)
+	falseCondPattern = regexp.MustCompile(`^(\w+) = false$`)
+	nullCondPattern  = regexp.MustCompile(`^(\w+) IS NULL$`)
+)
+
+// tokenDB is an in-memory refresh_tokens table that understands the statements the refresh
+// token repository runs. Users are all active, and a transaction is rolled back by restoring
+// the rows it started with.
+type tokenDB struct {
+	mu       sync.Mutex
+	rows     []map[string]driver.Value
+	snapshot []map[string]driver.Value
+	nextID   int64
+}
+
+func (d *tokenDB) Connect(context.Context) (driver.Conn, error) { return tokenConn{d}, nil }
+func (d *tokenDB) Driver() driver.Driver                        { return nil }
+
+// token returns the stored row with the given hash.
+func (d *tokenDB) token(t *testing.T, hash string) map[string]driver.Value {
+	t.Helper()
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	for _, row := range d.rows {
+		if row["token_hash"] == hash {
+			return row
+		}
+	}
+	t.Fatalf("no refresh token with hash %s", hash)
+	return nil
+}
+
+func copyRows(rows []map[string]driver.Value) []map[string]driver.Value {
+	copied := make([]map[string]driver.Value, len(rows))
+	for i, row := range rows {
+		copied[i] = make(map[string]driver.Value, len(row))
+		for column, value := range row {
+			copied[i][column] = value
+		}
+	}
+	return copied
+}
+
+type tokenConn struct {
+	db *tokenDB
+}
+
+func (c tokenConn) Prepare(string) (driver.Stmt, error) { return nil, errors.New("not supported") }
+func (c tokenConn) Close() error                        { return nil }
+
+func (c tokenConn) Begin() (driver.Tx, error) {
+	c.db.mu.Lock()
+	defer c.db.mu.Unlock()
+	c.db.snapshot = copyRows(c.db.rows)
+	return c, nil
+}
+
+func (c tokenConn) Commit() error {
+	c.db.mu.Lock()
+	defer c.db.mu.Unlock()
+	c.db.snapshot = nil
+	return nil
+}
+
+func (c tokenConn) Rollback() error {
+	c.db.mu.Lock()
+	defer c.db.mu.Unlock()
+	c.db.rows, c.db.snapshot = c.db.snapshot, nil
+	return nil
+}
+
+// matches reports whether row satisfies the conditions of the WHERE clause of query.
+func matches(row map[string]driver.Value, query string, args []driver.NamedValue) bool {
+	where := query[strings.Index(query, " WHERE ")+len(" WHERE "):]
+	if i := strings.Index(where, " ORDER BY "); i >= 0 {
+		where = where[:i]
+	}
+	where = strings.NewReplacer("(", "", ")", "").Replace(where)
+	for _, cond := range strings.Split(where, " AND ") {
+		switch {
+		case argCondPattern.MatchString(cond):
+			m := argCondPattern.FindStringSubmatch(cond)
+			n, _ := strconv.Atoi(m[2])
+			if fmt.Sprint(row[m[1]]) != fmt.Sprint(args[n-1].Value) {
+				return false
+			}
+		case falseCondPattern.MatchString(cond):
+			if row[falseCondPattern.FindStringSubmatch(cond)[1]] != false {
+				return false
+			}
+		case nullCondPattern.MatchString(cond):
+			if row[nullCondPattern.FindStringSubmatch(cond)[1]] != nil {
+				return false
+			}
+		default:
+			panic("unsupported condition " + cond)
+		}
+	}
+	return true
+}
+
+func (c tokenConn) QueryContext(_ context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	c.db.mu.Lock()
+	defer c.db.mu.Unlock()
+	switch {
+	case strings.HasPrefix(query, `INSERT INTO "refresh_tokens"`):
+		columns := strings.Split(insertPattern.FindStringSubmatch(query)[1], ",")
+		c.db.nextID++
+		row := map[string]driver.Value{"id": c.db.nextID}
+		for i, column := range columns {
+			row[strings.Trim(column, `"`)] = args[i].Value
+		}
+		c.db.rows = append(c.db.rows, row)
+		return &tokenRows{columns: []string{"id"}, values: [][]driver.Value{{c.db.nextID}}}, nil
+	case strings.HasPrefix(query, `SELECT * FROM "refresh_tokens"`):
+		rows := &tokenRows{columns: refreshTokenColumns}
+		for _, row := range c.db.rows {
+			if matches(row, query, args) {
+				values := make([]driver.Value, len(refreshTokenColumns))
+				for i, column := range refreshTokenColumns {
+					values[i] = row[column]
+				}
+				rows.values = append(rows.values, values)
+			}
+		}
+		return rows, nil
+	case strings.Contains(query, `FROM "users"`):
+		return &tokenRows{columns: []string{"id", "user_name", "role_id"}, values: [][]driver.Value{{args[0].Value, "user", int64(1)}}}, nil
+	}
+	return &tokenRows{}, nil
+}
+
+func (c tokenConn) ExecContext(_ context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	c.db.mu.Lock()
+	defer c.db.mu.Unlock()
+	if !strings.HasPrefix(query, `UPDATE "refresh_tokens" SET `) {
+		return nil, fmt.Errorf("unsupported statement %s", query)
+	}
+	set := setPattern.FindAllStringSubmatch(query[:strings.Index(query, " WHERE ")], -1)
+	var affected int64
+	for _, row := range c.db.rows {
+		if !matches(row, query, args) {
+			continue
+		}
+		for _, m := range set {
+			n, _ := strconv.Atoi(m[2])
+			row[m[1]] = args[n-1].Value
+		}
+		affected++
+	}
+	return driver.RowsAffected(affected), nil
+}
+
+type tokenRows struct {
+	columns []string
+	values  [][]driver.Value
+	next    int
+}
+
+func (r *tokenRows) Columns() []string { return r.columns }
+func (r *tokenRows) Close() error      { return nil }
+
+func (r *tokenRows) Next(dest []driver.Value) error {
+	if r.next >= len(r.values) {
+		return io.EOF
+	}
+	copy(dest, r.values[r.next])
+	r.next++
+	return nil
+}
+
+// discardLogs points the application loggers at io.Discard for the duration of the test.
+func discardLogs(t *testing.T) {
+	t.Helper()
+	previous := []*log.Logger{logger.Info, logger.Error, logger.Warning, logger.Debug}
+	logger.Info = log.New(io.Discard, "", 0)
+	logger.Error = log.New(io.Discard, "", 0)
+	logger.Warning = log.New(io.Discard, "", 0)
+	logger.Debug = log.New(io.Discard, "", 0)
+	t.Cleanup(func() {
+		logger.Info, logger.Error, logger.Warning, logger.Debug = previous[0], previous[1], previous[2], previous[3]
+	})
+}
+
+// useTokenDB makes the repositories store refresh tokens in a fresh tokenDB for the
+// duration of the test.
+func useTokenDB(t *testing.T) *tokenDB {
+	t.Helper()
+	discardLogs(t)
+	t.Setenv("JWT_SECRET_KEY", "test")
+	previousTtl := configs.AppSettings.AuthParams.RefreshTtlHours
+	configs.AppSettings.AuthParams.RefreshTtlHours = 1
+
+	tokens := &tokenDB{}
+	conn, err := gorm.Open(postgres.New(postgres.Config{Conn: sql.OpenDB(tokens)}), &gorm.Config{
+		DisableAutomaticPing: true,
+		Logger:               gormlogger.Discard,
+	})
+	if err != nil {
+		t.Fatalf("opening fake database: %v", err)
+	}
+	previous := db.GetDBConn()
+	db.SetDBConn(conn)
+	t.Cleanup(func() {
+		db.SetDBConn(previous)
+		configs.AppSettings.AuthParams.RefreshTtlHours = previousTtl
+	})
+	return tokens
+}
+
+// assertFamilyRevoked fails the test if a token of the family is still usable.
+func assertFamilyRevoked(t *testing.T, tokens *tokenDB, familyID any) {
+	t.Helper()
+	tokens.mu.Lock()
+	defer tokens.mu.Unlock()
+	for _, row := range tokens.rows {
+		if row["family_id"] == familyID && row["revoked_at"] == nil {
+			t.Errorf("refresh token with ID %v of family %v wasn't revoked", row["id"], familyID)
+		}
+	}
+}
+
+func TestRefreshTokensRotation(t *testing.T) {
+	tokens := useTokenDB(t)
+	first, err := CreateRefreshToken(1)
+	if err != nil {
+		t.Fatalf("CreateRefreshToken: %v", err)
+	}
+
+	accessToken, second, err := RefreshTokens(first)
+	if err != nil {
+		t.Fatalf("first rotation: %v", err)
+	}
+	if accessToken == "" || second == "" || second == first {
+		t.Fatalf("first rotation gave access token %q and refresh token %q", accessToken, second)
+	}
+	old, current := tokens.token(t, utils.GenerateHash(first)), tokens.token(t, utils.GenerateHash(second))
+	if old["revoked_at"] == nil || fmt.Sprint(old["replaced_by_id"]) != fmt.Sprint(current["id"]) {
+		t.Errorf("rotated token has revoked_at %v and replaced_by_id %v, want it replaced by %v",
+			old["revoked_at"], old["replaced_by_id"], current["id"])
+	}
+	if current["family_id"] != old["family_id"] || current["revoked_at"] != nil {
+		t.Errorf("new token has family %v and revoked_at %v, want an active token of family %v",
+			current["family_id"], current["revoked_at"], old["family_id"])
+	}
+
+	if _, _, err = RefreshTokens(first); !errors.Is(err, errs.ErrRefreshTokenReused) {
+		t.Fatalf("second rotation of the same token: error = %v, want %v", err, errs.ErrRefreshTokenReused)
+	}
+	assertFamilyRevoked(t, tokens, old["family_id"])
+	if _, _, err = RefreshTokens(second); !errors.Is(err, errs.ErrRefreshTokenReused) {
+		t.Errorf("rotation of the replacement after reuse: error = %v, want %v", err, errs.ErrRefreshTokenReused)
+	}
+}
+
+// TestRotateRefreshTokenTwice covers two requests that read the same token before either
+// rotates it: only the first rotation may succeed.
+func TestRotateRefreshTokenTwice(t *testing.T) {
+	tokens := useTokenDB(t)
+	first, err := CreateRefreshToken(1)
+	if err != nil {
+		t.Fatalf("CreateRefreshToken: %v", err)
+	}
+	token, err := repository.GetRefreshTokenByHash(utils.GenerateHash(first))
+	if err != nil {
+		t.Fatalf("GetRefreshTokenByHash: %v", err)
+	}
+
+	_, winner, _ := issueRefreshToken(token.UserID, token.FamilyID)
+	if err = repository.RotateRefreshToken(token.ID, winner); err != nil {
+		t.Fatalf("first rotation: %v", err)
+	}
+	_, loser, _ := issueRefreshToken(token.UserID, token.FamilyID)
+	if err = repository.RotateRefreshToken(token.ID, loser); !errors.Is(err, errs.ErrRefreshTokenReused) {
+		t.Fatalf("second rotation: error = %v, want %v", err, errs.ErrRefreshTokenReused)
+	}
+	tokens.mu.Lock()
+	stored := len(tokens.rows)
+	tokens.mu.Unlock()
+	if stored != 2 {
+		t.Errorf("%d refresh tokens are stored, want the losing one rolled back", stored)
+	}
+
+	if err = repository.RevokeRefreshTokenFamily(token.FamilyID); err != nil {
+		t.Fatalf("RevokeRefreshTokenFamily: %v", err)
+	}
+	assertFamilyRevoked(t, tokens, token.FamilyID)
+}
+
+func TestRefreshTokensRejected(t *testing.T) {
+	revokedAt := time.Now().Add(-time.Minute)
+	tests := []struct {
+		name    string
+		token   models.RefreshToken
+		wantErr error
+		revoked bool
+	}{
+		{
+			name:    "expired",
+			token:   models.RefreshToken{ExpiresAt: time.Now().Add(-time.Minute)},
+			wantErr: errs.ErrRefreshTokenExpired,
+		},
+		{
+			name:    "revoked",
+			token:   models.RefreshToken{ExpiresAt: time.Now().Add(time.Hour), RevokedAt: &revokedAt},
+			wantErr: errs.ErrRefreshTokenReused,
+			revoked: true,
+		},
+		{
+			name:    "unknown",
+			wantErr: errs.ErrInvalidToken,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tokens := useTokenDB(t)
+			sibling, err := CreateRefreshToken(1)
+			if err != nil {
+				t.Fatalf("CreateRefreshToken: %v", err)
+			}
+			familyID := tokens.token(t, utils.GenerateHash(sibling))["family_id"]
+			if !tt.token.ExpiresAt.IsZero() {
+				tt.token.UserID = 1
+				tt.token.TokenHash = utils.GenerateHash(tt.name)
+				tt.token.FamilyID = familyID.(string)
+				if err = repository.CreateRefreshToken(tt.token); err != nil {
+					t.Fatalf("CreateRefreshToken: %v", err)
+				}
+			}
+
+			accessToken, refreshToken, err := RefreshTokens(tt.name)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("RefreshTokens: error = %v, want %v", err, tt.wantErr)
+			}
+			if accessToken != "" || refreshToken != "" {
+				t.Errorf("RefreshTokens issued %q and %q for a rejected token", accessToken, refreshToken)
+			}
+			if tt.revoked {
+				assertFamilyRevoked(t, tokens, familyID)
+			} else if tokens.token(t, utils.GenerateHash(sibling))["revoked_at"] != nil {
+				t.Error("rejecting the token revoked the rest of its family")
+			}
+		})
+	}
+}
@@ -5,24 +5,18 @@ import (
 	"TajikCareerHub/models"
 	"TajikCareerHub/pkg/repository"
 	"TajikCareerHub/utils/errs"
+	"time"
 )
 
-func GetAllResumes(search string, minExperienceYears int, location string, category string, userID uint) (resumes []models.Resume, err error) {
+func GetAllResumes(filter models.ResumeFilter, userID uint, params models.PageParams) (resumes []models.Resume, info models.PageInfo, err error) {
 	if err := checkUserBlocked(userID); err != nil {
-		return nil, err
+		return nil, info, err
 	}
-	resumes, err = repository.GetAllResumes(search, minExperienceYears, location, category)
+	resumes, info, err = repository.GetAllResumes(filter, params)
 	if err != nil {
-		return nil, err
-	}
-	var filteredResumes []models.Resume
-	for _, resume := range resumes {
-		if err := checkResumeBlocked(resume.ID); err != nil {
-			continue
-		}
-		filteredResumes = append(filteredResumes, resume)
+		return nil, info, err
 	}
-	return filteredResumes, nil
+	return resumes, info, nil
 }
 
 func GetResumeByID(id uint, userID uint) (resume models.Resume, err error) {
@@ -53,6 +47,17 @@ func AddResume(resume models.Resume, userID uint) error {
 	if resume.UserID == 0 {
 		return errs.ErrIDIsNotCorrect
 	}
+	if resume.Skills != "" && resume.SkillTags == nil {
+		resume.SkillTags = models.ParseSkills(resume.Skills)
+	}
+	if err := prepareResumeSections(&resume); err != nil {
+		return err
+	}
+	locationID, location, err := resolveLocation(resume.LocationID, resume.Location)
+	if err != nil {
+		return err
+	}
+	resume.LocationID, resume.Location = locationID, location
 	if err := resume.ValidateResume(); err != nil {
 		logger.Error.Printf("[service.AddResume] validation error: %v\n", err)
 		return err
@@ -68,6 +73,9 @@ func UpdateResume(resumeID uint, updatedResume models.Resume, userID uint) error
 	if err != nil {
 		return err
 	}
+	if err := checkOwnership(userID, resume.UserID); err != nil {
+		return err
+	}
 	if err := checkResumeBlocked(resume.ID); err != nil {
 		return err
 	}
@@ -77,24 +85,39 @@ func UpdateResume(resumeID uint, updatedResume models.Resume, userID uint) error
 	if updatedResume.Summary != "" {
 		resume.Summary = updatedResume.Summary
 	}
-	if updatedResume.Skills != "" {
-		resume.Skills = updatedResume.Skills
+	// Flat fields backed by a structured section are derived from it and can't be set directly.
+	if updatedResume.Skills != "" && updatedResume.SkillTags == nil {
+		updatedResume.SkillTags = models.ParseSkills(updatedResume.Skills)
 	}
-	if updatedResume.ExperienceYears != 0 {
+	if updatedResume.ExperienceYears != 0 && len(resume.Experience) == 0 {
 		resume.ExperienceYears = updatedResume.ExperienceYears
 	}
-	if updatedResume.Education != "" {
+	if updatedResume.Education != "" && len(resume.EducationHistory) == 0 {
 		resume.Education = updatedResume.Education
 	}
 	if updatedResume.Certifications != "" {
 		resume.Certifications = updatedResume.Certifications
 	}
-	if updatedResume.Location != "" {
-		resume.Location = updatedResume.Location
+	if updatedResume.LocationID != nil || updatedResume.Location != "" {
+		if resume.LocationID, resume.Location, err = resolveLocation(updatedResume.LocationID, updatedResume.Location); err != nil {
+			return err
+		}
+	}
+	if updatedResume.ExpectedSalary != 0 {
+		resume.ExpectedSalary = updatedResume.ExpectedSalary
+	}
+	if updatedResume.ExpectedSalaryCurrency != "" {
+		resume.ExpectedSalaryCurrency = updatedResume.ExpectedSalaryCurrency
 	}
 	if updatedResume.VacancyCategoryID != 0 {
 		resume.VacancyCategoryID = updatedResume.VacancyCategoryID
 	}
+	resume.Experience = updatedResume.Experience
+	resume.EducationHistory = updatedResume.EducationHistory
+	resume.SkillTags = updatedResume.SkillTags
+	if err = prepareResumeSections(&resume); err != nil {
+		return err
+	}
 	err = resume.ValidateResume()
 	if err != nil {
 		logger.Error.Printf("[service.UpdateResume] validation error: %v\n", err)
@@ -104,21 +127,55 @@ func UpdateResume(resumeID uint, updatedResume models.Resume, userID uint) error
 	return repository.UpdateResume(resumeID, resume)
 }
 
+// prepareResumeSections normalizes the structured sections given in the resume and derives
+// the flat Skills, Education and ExperienceYears fields from them. Sections that are nil
+// were not sent by the client and leave the flat fields untouched. Skill tags known to the
+// skills dictionary are linked to it.
+func prepareResumeSections(resume *models.Resume) (err error) {
+	if resume.Experience != nil {
+		for i := range resume.Experience {
+			resume.Experience[i].ID = 0
+			resume.Experience[i].ResumeID = resume.ID
+		}
+		resume.ExperienceYears = models.ExperienceYears(resume.Experience, time.Now())
+	}
+	if resume.EducationHistory != nil {
+		for i := range resume.EducationHistory {
+			resume.EducationHistory[i].ID = 0
+			resume.EducationHistory[i].ResumeID = resume.ID
+		}
+		resume.Education = models.EducationText(resume.EducationHistory)
+	}
+	if resume.SkillTags != nil {
+		if resume.SkillTags, err = linkSkillTags(models.NormalizeSkills(resume.SkillTags)); err != nil {
+			return err
+		}
+		for i := range resume.SkillTags {
+			resume.SkillTags[i].ResumeID = resume.ID
+		}
+		resume.Skills = models.SkillsText(resume.SkillTags)
+	}
+	return nil
+}
+
 func DeleteResume(id uint, userID uint) error {
 	if err := checkUserBlocked(userID); err != nil {
 		return err
 	}
+	resume, err := repository.GetResumeByID(id)
+	if err != nil {
+		return err
+	}
+	if err := checkOwnership(userID, resume.UserID); err != nil {
+		return err
+	}
 	if err := checkResumeBlocked(id); err != nil {
 		return err
 	}
 	return repository.DeleteResume(id)
 }
 
-func BlockResume(id uint, userID uint, RoleID uint) (err error) {
-	if RoleID != 1 {
-		return errs.ErrAccessDenied
-	}
-
+func BlockResume(id uint, userID uint) (err error) {
 	if err := checkUserBlocked(userID); err != nil {
 		logger.Error.Printf("[service.BlockResume]: User %d is blocked", userID)
 		return errs.ErrUserBlocked
@@ -130,11 +187,7 @@ func BlockResume(id uint, userID uint, RoleID uint) (err error) {
 	return nil
 }
 
-func UnblockResume(id uint, userID uint, RoleID uint) (err error) {
-	if RoleID != 1 {
-		return errs.ErrAccessDenied
-	}
-
+func UnblockResume(id uint, userID uint) (err error) {
 	if err := checkUserBlocked(userID); err != nil {
 		logger.Error.Printf("[service.UnblockResume]: User %d is blocked", userID)
 		return errs.ErrUserBlocked
@@ -151,6 +204,13 @@ func GetResumeReportByID(resumeID uint, userID uint) (*models.ResumeReport, erro
 	if err != nil {
 		return nil, err
 	}
+	resume, err := repository.GetResumeByID(resumeID)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkOwnership(userID, resume.UserID); err != nil {
+		return nil, err
+	}
 	if err := checkResumeBlocked(resumeID); err != nil {
 		return nil, errs.ErrResumeBlocked
 	}
@@ -0,0 +1,33 @@
+package service
+
+import (
+	"TajikCareerHub/models"
+	"TajikCareerHub/pkg/repository"
+	"TajikCareerHub/utils/errs"
+	"errors"
+)
+
+func CheckPermission(roleID uint, permission string) (err error) {
+	allowed, err := repository.RoleHasPermission(roleID, permission)
+	if err != nil {
+		return err
+	}
+	if !allowed {
+		return errs.ErrAccessDenied
+	}
+	return nil
+}
+
+func validateSignUpRole(roleID uint) (err error) {
+	role, err := repository.GetRoleByID(roleID)
+	if err != nil {
+		if errors.Is(err, errs.ErrRecordNotFound) {
+			return errs.ErrInvalidRole
+		}
+		return err
+	}
+	if role.Name == models.RoleAdmin {
+		return errs.ErrRoleCannotBeAdmin
+	}
+	return nil
+}
@@ -0,0 +1,251 @@
+package service
+
+import (
+	"TajikCareerHub/configs"
+	"TajikCareerHub/logger"
+	"TajikCareerHub/models"
+	"TajikCareerHub/pkg/notifier"
+	"TajikCareerHub/pkg/repository"
+	"TajikCareerHub/utils/errs"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const defaultSavedSearchCheckInterval = 15 * time.Minute
+
+func GetMySavedSearches(userID uint, params models.PageParams) ([]models.SavedSearch, models.PageInfo, error) {
+	if err := checkUserBlocked(userID); err != nil {
+		return nil, models.PageInfo{}, err
+	}
+	return repository.GetSavedSearchesByUser(userID, params)
+}
+
+func GetSavedSearchByID(userID uint, searchID uint) (search models.SavedSearch, err error) {
+	if err = checkUserBlocked(userID); err != nil {
+		return models.SavedSearch{}, err
+	}
+	search, err = repository.GetSavedSearchByID(userID, searchID)
+	if err != nil {
+		if errors.Is(err, errs.ErrRecordNotFound) {
+			return models.SavedSearch{}, errs.ErrSavedSearchNotFound
+		}
+		return models.SavedSearch{}, err
+	}
+	return search, nil
+}
+
+// AddSavedSearch saves the search. The first digest comes one period later with the
+// vacancies published from now on.
+func AddSavedSearch(userID uint, input models.SwagSavedSearch) (search models.SavedSearch, err error) {
+	if err = checkUserBlocked(userID); err != nil {
+		return models.SavedSearch{}, err
+	}
+	search = newSavedSearch(input, true)
+	search.UserID = userID
+	search.LastRunAt = time.Now().Truncate(time.Microsecond)
+	if err = search.ValidateSavedSearch(); err != nil {
+		return models.SavedSearch{}, err
+	}
+
+	count, err := repository.CountSavedSearchesByUser(userID)
+	if err != nil {
+		return models.SavedSearch{}, err
+	}
+	if count >= models.MaxSavedSearchesPerUser {
+		return models.SavedSearch{}, errs.ErrTooManySavedSearches
+	}
+	if err = repository.AddSavedSearch(&search); err != nil {
+		return models.SavedSearch{}, err
+	}
+	return search, nil
+}
+
+func UpdateSavedSearch(userID uint, searchID uint, input models.SwagSavedSearch) (err error) {
+	current, err := GetSavedSearchByID(userID, searchID)
+	if err != nil {
+		return err
+	}
+	search := newSavedSearch(input, current.IsActive)
+	search.ID, search.UserID = current.ID, userID
+	// A search turned back on starts over from now, so that its first digest doesn't
+	// collect the vacancies published while it was off.
+	if search.IsActive && !current.IsActive {
+		search.LastRunAt = time.Now().Truncate(time.Microsecond)
+	}
+	if err = search.ValidateSavedSearch(); err != nil {
+		return err
+	}
+	return repository.UpdateSavedSearch(search)
+}
+
+func DeleteSavedSearch(userID uint, searchID uint) (err error) {
+	if err = checkUserBlocked(userID); err != nil {
+		return err
+	}
+	return repository.DeleteSavedSearch(userID, searchID)
+}
+
+func newSavedSearch(input models.SwagSavedSearch, isActive bool) models.SavedSearch {
+	if input.IsActive != nil {
+		isActive = *input.IsActive
+	}
+	if input.Frequency == "" {
+		input.Frequency = models.SavedSearchFrequencyDaily
+	}
+	return models.SavedSearch{
+		Name:      strings.TrimSpace(input.Name),
+		Filter:    input.Filter,
+		Frequency: input.Frequency,
+		IsActive:  isActive,
+	}
+}
+
+// RunSavedSearchJob sends the digests of the saved searches that are due through n, then
+// repeats every configured interval until ctx is cancelled.
+func RunSavedSearchJob(ctx context.Context, n notifier.Notifier) {
+	interval := time.Duration(configs.AppSettings.SavedSearchParams.CheckIntervalMinutes) * time.Minute
+	if interval <= 0 {
+		interval = defaultSavedSearchCheckInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if err := SendSavedSearchDigests(ctx, n, time.Now()); err != nil {
+			logger.Error.Printf("[service.RunSavedSearchJob] Error sending saved search digests: %v\n", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// SendSavedSearchDigests evaluates every due saved search against the vacancies published
+// since its last run and sends a digest when there are new ones. A search whose digest
+// couldn't be built or sent is retried on the next run.
+func SendSavedSearchDigests(ctx context.Context, n notifier.Notifier, now time.Time) (err error) {
+	now = now.Truncate(time.Microsecond)
+	searches, err := repository.GetDueSavedSearches(now)
+	if err != nil {
+		return err
+	}
+	sent := 0
+	for _, search := range searches {
+		if ctx.Err() != nil {
+			break
+		}
+		ok, err := sendSavedSearchDigest(n, search, now)
+		if err != nil {
+			logger.Error.Printf("[service.SendSavedSearchDigests] Error sending digest of saved search with ID %d: %v\n", search.ID, err)
+			continue
+		}
+		if ok {
+			sent++
+		}
+	}
+	if sent > 0 {
+		logger.Info.Printf("[service.SendSavedSearchDigests] Sent %d saved search digests\n", sent)
+	}
+	return nil
+}
+
+// sendSavedSearchDigest claims the run of the search by moving its run time to now and
+// sends the digest. On failure the run time is moved back so the vacancies aren't lost.
+func sendSavedSearchDigest(n notifier.Notifier, search models.SavedSearch, now time.Time) (sent bool, err error) {
+	claimed, err := repository.MoveSavedSearchRun(search.ID, search.LastRunAt, now)
+	if err != nil || !claimed {
+		return false, err
+	}
+
+	digest, err := buildSavedSearchDigest(search, now)
+	if err == nil && digest.Total > 0 {
+		err = n.Send(savedSearchDigestMessage(digest))
+		sent = err == nil
+	}
+	if err != nil {
+		if _, restoreErr := repository.MoveSavedSearchRun(search.ID, now, search.LastRunAt); restoreErr != nil {
+			logger.Error.Printf("[service.sendSavedSearchDigest] Error restoring run time of saved search with ID %d: %v\n", search.ID, restoreErr)
+		}
+		return false, err
+	}
+	return sent, nil
+}
+
+// buildSavedSearchDigest collects the vacancies matching the search that were published
+// after its last run and no later than now.
+func buildSavedSearchDigest(search models.SavedSearch, now time.Time) (digest models.SavedSearchDigest, err error) {
+	filter := search.Filter.VacancyFilter()
+	lastRunAt := search.LastRunAt
+	filter.PublishedAfter, filter.PublishedBefore = &lastRunAt, &now
+	if filter, err = prepareVacancyFilter(filter); err != nil {
+		return digest, err
+	}
+
+	size := configs.AppSettings.SavedSearchParams.MaxVacanciesPerDigest
+	if size <= 0 || size > models.MaxPageSize {
+		size = models.DefaultVacanciesPerDigest
+	}
+	viewer, err := getSalaryViewer(search.UserID)
+	if err != nil {
+		return digest, err
+	}
+	vacancies, info, err := repository.GetAllVacancies(filter, configs.AppSettings.ExchangeRates, viewer, models.PageParams{
+		Page: 1,
+		Size: size,
+		Sort: []models.SortParam{{Field: "created_at", Desc: true}},
+	})
+	if err != nil {
+		return digest, err
+	}
+	for i := range vacancies {
+		hideSalary(viewer, &vacancies[i])
+	}
+	return models.SavedSearchDigest{Search: search, Vacancies: vacancies, Total: info.Total}, nil
+}
+
+func savedSearchDigestMessage(digest models.SavedSearchDigest) notifier.Message {
+	var body strings.Builder
+	fmt.Fprintf(&body, "Hello, %s!\n\n", digest.Search.User.FullName)
+	fmt.Fprintf(&body, "There are %d new vacancies for your saved search %q:\n", digest.Total, digest.Search.Name)
+	for _, vacancy := range digest.Vacancies {
+		fmt.Fprintf(&body, "\n%s — %s\n", vacancy.Title, vacancy.Company.Name)
+		if vacancy.Location != "" {
+			fmt.Fprintf(&body, "Location: %s\n", vacancy.Location)
+		}
+		fmt.Fprintf(&body, "Salary: %s\n", formatDigestSalary(vacancy))
+		fmt.Fprintf(&body, "Vacancy ID: %d\n", vacancy.ID)
+	}
+	if rest := digest.Total - int64(len(digest.Vacancies)); rest > 0 {
+		fmt.Fprintf(&body, "\nand %d more.\n", rest)
+	}
+	body.WriteString("\nYou receive this message because of your saved search on Tajik Career Hub. Turn the search off to stop the digests.\n")
+
+	return notifier.Message{
+		ToName:  digest.Search.User.FullName,
+		To:      digest.Search.User.Email,
+		Subject: fmt.Sprintf("%d new vacancies for %q", digest.Total, digest.Search.Name),
+		Body:    body.String(),
+	}
+}
+
+func formatDigestSalary(vacancy models.Vacancy) string {
+	period := ""
+	if vacancy.SalaryPeriod == models.SalaryPeriodHour {
+		period = " per hour"
+	}
+	switch {
+	case vacancy.SalaryHidden:
+		return "not disclosed"
+	case vacancy.SalaryMin > 0 && vacancy.SalaryMax > 0:
+		return fmt.Sprintf("%.0f–%.0f %s%s", vacancy.SalaryMin, vacancy.SalaryMax, vacancy.Currency, period)
+	case vacancy.SalaryMin > 0:
+		return fmt.Sprintf("from %.0f %s%s", vacancy.SalaryMin, vacancy.Currency, period)
+	case vacancy.SalaryMax > 0:
+		return fmt.Sprintf("up to %.0f %s%s", vacancy.SalaryMax, vacancy.Currency, period)
+	}
+	return "negotiable"
+}
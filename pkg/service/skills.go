@@ -0,0 +1,131 @@
+package service
+
+import (
+	"TajikCareerHub/logger"
+	"TajikCareerHub/models"
+	"TajikCareerHub/pkg/repository"
+	"TajikCareerHub/utils/errs"
+	"errors"
+)
+
+func GetAllSkills(search string, params models.PageParams) (skills []models.Skill, info models.PageInfo, err error) {
+	skills, info, err = repository.GetAllSkills(search, params)
+	if err != nil {
+		return nil, info, err
+	}
+	return skills, info, nil
+}
+
+func GetSkillByID(id uint) (skill models.Skill, err error) {
+	skill, err = repository.GetSkillByID(id)
+	if err != nil {
+		if errors.Is(err, errs.ErrRecordNotFound) {
+			return models.Skill{}, errs.ErrSkillNotFound
+		}
+		return models.Skill{}, err
+	}
+	return skill, nil
+}
+
+func AddSkill(skill models.Skill) (err error) {
+	skill.ID = 0
+	skill.Normalize()
+	if err = skill.ValidateSkill(); err != nil {
+		logger.Error.Printf("[service.AddSkill] validation error: %v\n", err)
+		return err
+	}
+	if err = checkSkillNamesAvailable(skill); err != nil {
+		return err
+	}
+	return repository.AddSkill(skill)
+}
+
+func UpdateSkill(id uint, skill models.Skill) (err error) {
+	if _, err = GetSkillByID(id); err != nil {
+		return err
+	}
+	skill.ID = id
+	skill.Normalize()
+	if err = skill.ValidateSkill(); err != nil {
+		logger.Error.Printf("[service.UpdateSkill] validation error: %v\n", err)
+		return err
+	}
+	if err = checkSkillNamesAvailable(skill); err != nil {
+		return err
+	}
+	return repository.UpdateSkill(skill)
+}
+
+func DeleteSkill(id uint) (err error) {
+	if _, err = GetSkillByID(id); err != nil {
+		return err
+	}
+	return repository.DeleteSkill(id)
+}
+
+// checkSkillNamesAvailable makes sure that neither the name nor the aliases of the skill
+// are used by another skill, so that every name resolves to a single skill.
+func checkSkillNamesAvailable(skill models.Skill) (err error) {
+	existing, err := repository.GetSkillsByNames(skill.SkillNames())
+	if err != nil {
+		return err
+	}
+	for _, other := range existing {
+		if other.ID != skill.ID {
+			logger.Warning.Printf("[service.checkSkillNamesAvailable] Skill %q clashes with skill %d %q\n", skill.Name, other.ID, other.Name)
+			return errs.ErrSkillAlreadyExist
+		}
+	}
+	return nil
+}
+
+// checkSkillIDs removes repeated IDs and makes sure that all the skills exist.
+// nil is returned as is, meaning that the skills were not sent.
+func checkSkillIDs(ids []uint) ([]uint, error) {
+	if ids == nil {
+		return nil, nil
+	}
+	unique := make([]uint, 0, len(ids))
+	seen := make(map[uint]bool, len(ids))
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			unique = append(unique, id)
+		}
+	}
+	skills, err := repository.GetSkillsByIDs(unique)
+	if err != nil {
+		return nil, err
+	}
+	if len(skills) != len(unique) {
+		return nil, errs.ErrSkillNotFound
+	}
+	return unique, nil
+}
+
+// linkSkillTags links normalized resume skill tags to the skills dictionary. Tags matching
+// an alias are renamed to the skill's canonical name, so "golang" becomes "go".
+func linkSkillTags(tags []models.ResumeSkill) ([]models.ResumeSkill, error) {
+	names := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		names = append(names, tag.Name)
+	}
+	skills, err := repository.GetSkillsByNames(names)
+	if err != nil {
+		return nil, err
+	}
+	byName := make(map[string]models.Skill)
+	for _, skill := range skills {
+		for _, name := range skill.SkillNames() {
+			byName[name] = skill
+		}
+	}
+	for i, tag := range tags {
+		if skill, ok := byName[tag.Name]; ok {
+			skillID := skill.ID
+			tags[i].SkillID = &skillID
+			tags[i].Name = skill.Name
+		}
+	}
+	return models.NormalizeSkills(tags), nil
+}
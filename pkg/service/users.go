@@ -8,16 +8,13 @@ import (
 	"TajikCareerHub/utils/errs"
 )
 
-func GetAllUsers(RoleID uint) (users []models.User, err error) {
-	if RoleID != 1 {
-		return nil, errs.ErrAccessDenied
-	}
-	users, err = repository.GetAllUsers()
+func GetAllUsers(params models.PageParams) (users []models.User, info models.PageInfo, err error) {
+	users, info, err = repository.GetAllUsers(params)
 	if err != nil {
 		logger.Error.Printf("[service.GetAllUsers] Error retrieving users: %v\n", err)
-		return nil, err
+		return nil, info, err
 	}
-	return users, nil
+	return users, info, nil
 }
 
 func GetUserByID(id uint) (user models.User, err error) {
@@ -52,6 +49,10 @@ func CreateUser(user models.User) (uint, error) {
 		logger.Error.Printf("[service.CreateUser] validation error: %v\n", err)
 		return 0, err
 	}
+	if err := validateSignUpRole(user.RoleID); err != nil {
+		logger.Error.Printf("[service.CreateUser] role validation error: %v\n", err)
+		return 0, err
+	}
 
 	existingUser, err := repository.GetUserByUsername(user.UserName)
 	if err == nil && existingUser != nil {
@@ -59,7 +60,11 @@ func CreateUser(user models.User) (uint, error) {
 		return 0, errs.ErrUsernameExists
 	}
 
-	user.Password = utils.GenerateHash(user.Password)
+	user.Password, err = utils.HashPassword(user.Password)
+	if err != nil {
+		logger.Error.Printf("[service.CreateUser] error hashing password: %v\n", err)
+		return 0, err
+	}
 	id, err := repository.CreateUser(user)
 	if err != nil {
 		return 0, err
@@ -109,17 +114,19 @@ func DeleteUser(id uint, userID uint) (err error) {
 	if err != nil {
 		return errs.ErrUserBlocked
 	}
+	if err = checkOwnership(userID, id); err != nil {
+		return err
+	}
 
 	err = repository.DeleteUser(id)
 	if err != nil {
 		return err
 	}
-	return nil
+	return invalidateUserSessions(id)
 }
 
 func UpdateUserPassword(userID uint, username string, oldPassword string, newPassword string) (err error) {
-	hashedOldPassword := utils.GenerateHash(oldPassword)
-	user, err := repository.GetUserByUsernameAndPassword(username, hashedOldPassword)
+	user, err := authenticateUser(username, oldPassword)
 	if err != nil {
 		return errs.ErrIncorrectPassword
 	}
@@ -127,18 +134,19 @@ func UpdateUserPassword(userID uint, username string, oldPassword string, newPas
 		return errs.ErrUserIdDoesNotMatchTheProvidedUsername
 	}
 
-	hashedNewPassword := utils.GenerateHash(newPassword)
+	hashedNewPassword, err := utils.HashPassword(newPassword)
+	if err != nil {
+		logger.Error.Printf("[service.UpdateUserPassword] error hashing password: %v\n", err)
+		return err
+	}
 	err = repository.UpdateUserPassword(userID, hashedNewPassword)
 	if err != nil {
 		return err
 	}
-	return nil
+	return invalidateUserSessions(userID)
 }
 
-func BlockUser(id uint, RoleID uint) (err error) {
-	if RoleID != 1 {
-		return errs.ErrAccessDenied
-	}
+func BlockUser(id uint) (err error) {
 	if id == 0 {
 		logger.Error.Printf("[service.BlockUser] Invalid ID: %v", id)
 		return errs.ErrIDIsNotCorrect
@@ -149,15 +157,10 @@ func BlockUser(id uint, RoleID uint) (err error) {
 		logger.Error.Printf("[service.BlockUser] Failed to block user with ID %v: %v", id, err)
 		return err
 	}
-	return nil
+	return invalidateUserSessions(id)
 }
 
-func UnblockUser(id uint, RoleID uint) (err error) {
-
-	if RoleID != 1 {
-		return errs.ErrAccessDenied
-	}
-
+func UnblockUser(id uint) (err error) {
 	if id == 0 {
 		logger.Error.Printf("[service.UnblockUser] Invalid ID: %v", id)
 		return errs.ErrIDIsNotCorrect
@@ -1,32 +1,115 @@
 package service
 
 import (
+	"TajikCareerHub/configs"
 	"TajikCareerHub/logger"
 	"TajikCareerHub/models"
 	"TajikCareerHub/pkg/repository"
 	"TajikCareerHub/utils/errs"
+	"errors"
+	"time"
 )
 
-func GetAllVacancies(userID uint, search string, minSalary int, maxSalary int, location string, category string, sort string) ([]models.Vacancy, error) {
+func GetAllVacancies(userID uint, filter models.VacancyFilter, params models.PageParams) ([]models.Vacancy, models.PageInfo, error) {
 	if err := checkUserBlocked(userID); err != nil {
-		return nil, err
+		return nil, models.PageInfo{}, err
 	}
-	vacancies, err := repository.GetAllVacancies(search, minSalary, maxSalary, location, category, sort)
+	filter, err := prepareVacancyFilter(filter)
 	if err != nil {
-		return nil, err
+		return nil, models.PageInfo{}, err
 	}
-	var filteredVacancies []models.Vacancy
-	for _, vacancy := range vacancies {
-		if err := checkVacancyBlocked(vacancy.ID); err != nil {
-			continue
-		}
-		if err := checkUserBlocked(vacancy.UserID); err != nil {
-			continue
+	viewer, err := getSalaryViewer(userID)
+	if err != nil {
+		return nil, models.PageInfo{}, err
+	}
+	vacancies, info, err := repository.GetAllVacancies(filter, configs.AppSettings.ExchangeRates, viewer, params)
+	if err != nil {
+		return nil, info, err
+	}
+	for i := range vacancies {
+		hideSalary(viewer, &vacancies[i])
+	}
+	return vacancies, info, nil
+}
+
+// prepareVacancyFilter validates the filter and converts the salary filter to monthly
+// amounts in the base currency.
+func prepareVacancyFilter(filter models.VacancyFilter) (models.VacancyFilter, error) {
+	if err := filter.ValidateVacancyFilter(); err != nil {
+		return models.VacancyFilter{}, err
+	}
+	if filter.MinSalary <= 0 && filter.MaxSalary <= 0 {
+		return filter, nil
+	}
+	rates := configs.AppSettings.ExchangeRates
+	if filter.SalaryCurrency == "" {
+		filter.SalaryCurrency = rates.BaseCurrency
+	}
+	if !models.IsValidCurrency(filter.SalaryCurrency) {
+		return models.VacancyFilter{}, errs.ErrInvalidCurrency
+	}
+	var ok bool
+	if filter.MinSalary, ok = rates.ToMonthlyBase(filter.MinSalary, filter.SalaryCurrency, models.SalaryPeriodMonth); !ok {
+		logger.Error.Printf("[service.prepareVacancyFilter] No exchange rate for %s\n", filter.SalaryCurrency)
+		return models.VacancyFilter{}, errs.ErrInvalidCurrency
+	}
+	filter.MaxSalary, _ = rates.ToMonthlyBase(filter.MaxSalary, filter.SalaryCurrency, models.SalaryPeriodMonth)
+	filter.SalaryCurrency = rates.BaseCurrency
+	return filter, nil
+}
+
+// getSalaryViewer resolves whose hidden salaries the user sees, once per request, so that
+// lists of vacancies are checked without a query per vacancy. Like checkVacancyAccess, it
+// lets the vacancy authors, members of their companies and admins see the amounts.
+func getSalaryViewer(userID uint) (viewer models.SalaryViewer, err error) {
+	viewer.UserID = userID
+	if viewer.All, err = canManageAnyResource(userID); err != nil || viewer.All {
+		return viewer, err
+	}
+	viewer.CompanyIDs, err = repository.GetMemberCompanyIDs(userID)
+	return viewer, err
+}
+
+// hideSalary removes the amounts of a hidden salary unless the viewer manages the vacancy.
+func hideSalary(viewer models.SalaryViewer, vacancy *models.Vacancy) {
+	if !viewer.CanSeeSalary(*vacancy) {
+		vacancy.SalaryMin, vacancy.SalaryMax = 0, 0
+	}
+}
+
+// checkVacancyAccess allows the author of the vacancy and members of its company to manage
+// the vacancy's applications.
+func checkVacancyAccess(userID uint, vacancy models.Vacancy) (err error) {
+	if vacancy.UserID == userID {
+		return nil
+	}
+	if err := checkCompanyMembership(userID, vacancy.CompanyID); err != nil {
+		if errors.Is(err, errs.ErrNotCompanyMember) {
+			return errs.ErrAccessDenied
 		}
-		filteredVacancies = append(filteredVacancies, vacancy)
+		return err
+	}
+	return nil
+}
+
+// checkVacancyManager is checkVacancyAccess that also lets admins through.
+func checkVacancyManager(userID uint, vacancy models.Vacancy) (err error) {
+	if err = checkVacancyAccess(userID, vacancy); !errors.Is(err, errs.ErrAccessDenied) {
+		return err
 	}
+	return checkOwnership(userID)
+}
 
-	return filteredVacancies, nil
+// GetMyVacancies lists the vacancies the user manages in every status, including drafts
+// and closed vacancies that aren't listed anywhere else.
+func GetMyVacancies(userID uint, status string, params models.PageParams) ([]models.Vacancy, models.PageInfo, error) {
+	if err := checkUserBlocked(userID); err != nil {
+		return nil, models.PageInfo{}, err
+	}
+	if status != "" && !models.IsValidVacancyStatus(status) {
+		return nil, models.PageInfo{}, errs.ErrInvalidVacancyStatus
+	}
+	return repository.GetManagedVacancies(userID, status, params)
 }
 
 func GetVacancyByID(userID uint, vacancyID uint) (vacancy models.Vacancy, err error) {
@@ -42,10 +125,28 @@ func GetVacancyByID(userID uint, vacancyID uint) (vacancy models.Vacancy, err er
 	if err := checkVacancyBlocked(vacancyID); err != nil {
 		return models.Vacancy{}, err
 	}
+	if vacancy.UserID != userID {
+		if err := checkCompanyAvailable(vacancy.Company); err != nil {
+			return models.Vacancy{}, err
+		}
+	}
+	if !vacancy.IsOpen(time.Now()) {
+		if err := checkVacancyManager(userID, vacancy); err != nil {
+			if errors.Is(err, errs.ErrAccessDenied) {
+				return models.Vacancy{}, errs.ErrVacancyNotFound
+			}
+			return models.Vacancy{}, err
+		}
+	}
 
 	if err := repository.RecordVacancyView(userID, vacancyID); err != nil {
 		return models.Vacancy{}, err
 	}
+	viewer, err := getSalaryViewer(userID)
+	if err != nil {
+		return models.Vacancy{}, err
+	}
+	hideSalary(viewer, &vacancy)
 	return vacancy, nil
 }
 
@@ -54,10 +155,32 @@ func AddVacancy(userID uint, vacancy models.Vacancy) (err error) {
 		return err
 	}
 	vacancy.UserID = userID
+	vacancy.SetSalaryDefaults()
+	vacancy.SetEmploymentDefaults()
+	vacancy.Languages = models.NormalizeLanguages(vacancy.Languages)
+	if vacancy.LocationID, vacancy.Location, err = resolveLocation(vacancy.LocationID, vacancy.Location); err != nil {
+		return err
+	}
 	if err := vacancy.ValidateVacancy(); err != nil {
 		logger.Error.Printf("[service.AddVacancy] validation error: %v\n", err)
 		return err
 	}
+	company, err := repository.GetCompanyByID(vacancy.CompanyID)
+	if err != nil {
+		return err
+	}
+	if company.IsBlocked {
+		return errs.ErrCompanyBlocked
+	}
+	if err := checkCompanyMembership(userID, vacancy.CompanyID); err != nil {
+		return err
+	}
+	if vacancy.SkillIDs, err = checkSkillIDs(vacancy.SkillIDs); err != nil {
+		return err
+	}
+	if err = setInitialVacancyStatus(&vacancy, time.Now()); err != nil {
+		return err
+	}
 	return repository.AddVacancy(vacancy)
 }
 
@@ -69,6 +192,9 @@ func UpdateVacancy(userID uint, vacancyID uint, updatedVacancy models.Vacancy) (
 	if err != nil {
 		return err
 	}
+	if err := checkVacancyManager(userID, vacancy); err != nil {
+		return err
+	}
 	if err := checkVacancyBlocked(vacancyID); err != nil {
 		return err
 	}
@@ -79,14 +205,43 @@ func UpdateVacancy(userID uint, vacancyID uint, updatedVacancy models.Vacancy) (
 	if updatedVacancy.Description != "" {
 		vacancy.Description = updatedVacancy.Description
 	}
-	if updatedVacancy.Location != "" {
-		vacancy.Location = updatedVacancy.Location
+	if updatedVacancy.LocationID != nil || updatedVacancy.Location != "" {
+		if vacancy.LocationID, vacancy.Location, err = resolveLocation(updatedVacancy.LocationID, updatedVacancy.Location); err != nil {
+			return err
+		}
 	}
 	if updatedVacancy.VacancyCategoryID != 0 {
 		vacancy.VacancyCategoryID = updatedVacancy.VacancyCategoryID
 	}
-	if updatedVacancy.Salary != 0 {
-		vacancy.Salary = updatedVacancy.Salary
+	// The salary is replaced as a whole, so a range can be turned into a negotiable salary.
+	if updatedVacancy.HasSalary() {
+		vacancy.SalaryMin = updatedVacancy.SalaryMin
+		vacancy.SalaryMax = updatedVacancy.SalaryMax
+		vacancy.Currency = updatedVacancy.Currency
+		vacancy.SalaryPeriod = updatedVacancy.SalaryPeriod
+		vacancy.SalaryBasis = updatedVacancy.SalaryBasis
+		vacancy.SalaryNegotiable = updatedVacancy.SalaryNegotiable
+		vacancy.SalaryHidden = updatedVacancy.SalaryHidden
+		vacancy.SetSalaryDefaults()
+	}
+	if updatedVacancy.EmploymentType != "" {
+		vacancy.EmploymentType = updatedVacancy.EmploymentType
+	}
+	if updatedVacancy.WorkSchedule != "" {
+		vacancy.WorkSchedule = updatedVacancy.WorkSchedule
+	}
+	if updatedVacancy.RemoteMode != "" {
+		vacancy.RemoteMode = updatedVacancy.RemoteMode
+	}
+	// Like the salary, the experience range is replaced as a whole, so the minimum can be
+	// lowered to 0 and the upper limit removed.
+	if updatedVacancy.HasExperience() {
+		vacancy.MinExperienceYears = updatedVacancy.MinExperienceYears
+		vacancy.MaxExperienceYears = updatedVacancy.MaxExperienceYears
+	}
+	vacancy.Languages = models.NormalizeLanguages(updatedVacancy.Languages)
+	if vacancy.SkillIDs, err = checkSkillIDs(updatedVacancy.SkillIDs); err != nil {
+		return err
 	}
 
 	err = vacancy.ValidateVacancy()
@@ -105,6 +260,13 @@ func DeleteVacancy(userID uint, vacancyID uint) (err error) {
 	if err := checkUserBlocked(userID); err != nil {
 		return err
 	}
+	vacancy, err := repository.GetVacancyByID(vacancyID)
+	if err != nil {
+		return err
+	}
+	if err := checkVacancyManager(userID, vacancy); err != nil {
+		return err
+	}
 	if err := checkVacancyBlocked(vacancyID); err != nil {
 		return err
 	}
@@ -115,8 +277,15 @@ func DeleteVacancy(userID uint, vacancyID uint) (err error) {
 	return nil
 }
 
-func GetVacancyReportByID(vacancyID uint) (*models.VacancyReport, error) {
-	err := checkVacancyBlocked(vacancyID)
+func GetVacancyReportByID(userID uint, vacancyID uint) (*models.VacancyReport, error) {
+	vacancy, err := repository.GetVacancyByID(vacancyID)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkVacancyManager(userID, vacancy); err != nil {
+		return nil, err
+	}
+	err = checkVacancyBlocked(vacancyID)
 	if err != nil {
 		return nil, errs.ErrVacancyBlocked
 	}
@@ -132,11 +301,7 @@ func GetVacancyReportByID(vacancyID uint) (*models.VacancyReport, error) {
 	return report, nil
 }
 
-func BlockVacancy(userID uint, vacancyID uint, RoleID uint) (err error) {
-
-	if RoleID != 1 {
-		return errs.ErrAccessDenied
-	}
+func BlockVacancy(userID uint, vacancyID uint) (err error) {
 	if err := checkUserBlocked(userID); err != nil {
 		return err
 	}
@@ -150,11 +315,7 @@ func BlockVacancy(userID uint, vacancyID uint, RoleID uint) (err error) {
 	return nil
 }
 
-func UnblockVacancy(userID uint, vacancyID uint, RoleID uint) (err error) {
-	if RoleID != 1 {
-		return errs.ErrAccessDenied
-	}
-
+func UnblockVacancy(userID uint, vacancyID uint) (err error) {
 	if err := checkUserBlocked(userID); err != nil {
 		return err
 	}
@@ -8,12 +8,12 @@ import (
 	"gorm.io/gorm"
 )
 
-func GetAllCategories() (categories []models.VacancyCategory, err error) {
-	categories, err = repository.GetAllCategories()
+func GetAllCategories(params models.PageParams) (categories []models.VacancyCategory, info models.PageInfo, err error) {
+	categories, info, err = repository.GetAllCategories(params)
 	if err != nil {
-		return nil, err
+		return nil, info, err
 	}
-	return categories, nil
+	return categories, info, nil
 }
 
 func GetCategoryByID(id uint) (category models.VacancyCategory, err error) {
@@ -27,10 +27,7 @@ func GetCategoryByID(id uint) (category models.VacancyCategory, err error) {
 	return category, nil
 }
 
-func AddCategory(category models.VacancyCategory, RoleID uint) (err error) {
-	if RoleID != 1 {
-		return errs.ErrPermissionDenied
-	}
+func AddCategory(category models.VacancyCategory) (err error) {
 	existingCategory, err := repository.GetCategoryByName(category.Name)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
@@ -44,10 +41,7 @@ func AddCategory(category models.VacancyCategory, RoleID uint) (err error) {
 	return repository.AddCategory(category)
 }
 
-func UpdateCategory(category models.VacancyCategory, RoleID uint) (err error) {
-	if RoleID != 1 {
-		return errs.ErrPermissionDenied
-	}
+func UpdateCategory(category models.VacancyCategory) (err error) {
 	existingCategory, err := repository.GetCategoryByID(category.ID)
 	if err != nil {
 		if errors.Is(err, errs.ErrRecordNotFound) {
@@ -65,10 +59,7 @@ func UpdateCategory(category models.VacancyCategory, RoleID uint) (err error) {
 	return nil
 }
 
-func DeleteCategory(id uint, RoleID uint) (err error) {
-	if RoleID != 1 {
-		return errs.ErrPermissionDenied
-	}
+func DeleteCategory(id uint) (err error) {
 	existingCategory, err := repository.GetCategoryByID(id)
 	if err != nil {
 		if errors.Is(err, errs.ErrRecordNotFound) {
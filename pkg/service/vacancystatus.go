@@ -0,0 +1,181 @@
+package service
+
+import (
+	"TajikCareerHub/configs"
+	"TajikCareerHub/logger"
+	"TajikCareerHub/models"
+	"TajikCareerHub/pkg/repository"
+	"TajikCareerHub/utils/errs"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+const defaultExpiryCheckInterval = 10 * time.Minute
+
+// vacancyExpiry returns when a vacancy published at now expires. The requested date must be
+// in the future and within the configured maximum lifetime, without it the default lifetime
+// is used. A nil expiry means the vacancy doesn't expire.
+func vacancyExpiry(requested *time.Time, now time.Time) (*time.Time, error) {
+	params := configs.AppSettings.VacancyParams
+	if requested == nil {
+		if params.DefaultLifetimeDays <= 0 {
+			return nil, nil
+		}
+		expiresAt := now.AddDate(0, 0, params.DefaultLifetimeDays)
+		return &expiresAt, nil
+	}
+	if !requested.After(now) {
+		return nil, errs.ErrInvalidVacancyExpiry
+	}
+	if params.MaxLifetimeDays > 0 && requested.After(now.AddDate(0, 0, params.MaxLifetimeDays)) {
+		return nil, errs.ErrInvalidVacancyExpiry
+	}
+	return requested, nil
+}
+
+// setInitialVacancyStatus prepares the lifecycle fields of a new vacancy: it is created as
+// a draft unless it is published right away.
+func setInitialVacancyStatus(vacancy *models.Vacancy, now time.Time) (err error) {
+	vacancy.PublishedAt, vacancy.LastPublishedAt, vacancy.ClosedAt = nil, nil, nil
+	switch vacancy.Status {
+	case "", models.VacancyStatusDraft:
+		vacancy.Status = models.VacancyStatusDraft
+		vacancy.ExpiresAt = nil
+	case models.VacancyStatusPublished:
+		if vacancy.ExpiresAt, err = vacancyExpiry(vacancy.ExpiresAt, now); err != nil {
+			return err
+		}
+		vacancy.PublishedAt, vacancy.LastPublishedAt = &now, &now
+	default:
+		return errs.ErrInvalidVacancyStatus
+	}
+	return nil
+}
+
+func getManagedVacancy(userID uint, vacancyID uint) (vacancy models.Vacancy, err error) {
+	if err = checkUserBlocked(userID); err != nil {
+		return models.Vacancy{}, err
+	}
+	vacancy, err = repository.GetVacancyByID(vacancyID)
+	if err != nil {
+		if errors.Is(err, errs.ErrRecordNotFound) {
+			return models.Vacancy{}, errs.ErrVacancyNotFound
+		}
+		return models.Vacancy{}, err
+	}
+	if err = checkVacancyManager(userID, vacancy); err != nil {
+		return models.Vacancy{}, err
+	}
+	return vacancy, nil
+}
+
+func changeVacancyStatus(vacancy models.Vacancy, status string, updates map[string]interface{}) (err error) {
+	if !models.CanTransitionVacancyStatus(vacancy.Status, status) {
+		logger.Warning.Printf("[service.changeVacancyStatus] Vacancy with ID %d can't move from %s to %s\n", vacancy.ID, vacancy.Status, status)
+		return errs.ErrInvalidStatusTransition
+	}
+	updates["status"] = status
+	return repository.UpdateVacancyStatus(vacancy.ID, vacancy.Status, updates)
+}
+
+// PublishVacancy lists a draft, paused or expired vacancy. A paused vacancy keeps its expiry
+// date unless a new one is given or it has passed in the meantime.
+func PublishVacancy(userID uint, vacancyID uint, input models.SwagVacancyPublish) (err error) {
+	vacancy, err := getManagedVacancy(userID, vacancyID)
+	if err != nil {
+		return err
+	}
+	if vacancy.IsBlocked {
+		return errs.ErrVacancyBlocked
+	}
+	if err = checkCompanyAvailable(vacancy.Company); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	expiresAt := vacancy.ExpiresAt
+	if input.ExpiresAt != nil || vacancy.Status != models.VacancyStatusPaused || expiresAt == nil || !expiresAt.After(now) {
+		if expiresAt, err = vacancyExpiry(input.ExpiresAt, now); err != nil {
+			return err
+		}
+	}
+	updates := map[string]interface{}{"expires_at": expiresAt, "last_published_at": now}
+	if vacancy.PublishedAt == nil {
+		updates["published_at"] = now
+	}
+	return changeVacancyStatus(vacancy, models.VacancyStatusPublished, updates)
+}
+
+// PauseVacancy hides a published vacancy from the listings until it is published again.
+func PauseVacancy(userID uint, vacancyID uint) (err error) {
+	vacancy, err := getManagedVacancy(userID, vacancyID)
+	if err != nil {
+		return err
+	}
+	return changeVacancyStatus(vacancy, models.VacancyStatusPaused, map[string]interface{}{})
+}
+
+// CloseVacancy finishes the vacancy as closed or, when the position was taken, as filled.
+func CloseVacancy(userID uint, vacancyID uint, status string) (err error) {
+	if status == "" {
+		status = models.VacancyStatusClosed
+	}
+	if status != models.VacancyStatusClosed && status != models.VacancyStatusFilled {
+		return errs.ErrInvalidVacancyStatus
+	}
+	vacancy, err := getManagedVacancy(userID, vacancyID)
+	if err != nil {
+		return err
+	}
+	return changeVacancyStatus(vacancy, status, map[string]interface{}{"closed_at": time.Now()})
+}
+
+// RunVacancyExpiryJob expires the vacancies whose expiry date has passed and notifies their
+// owners, then repeats every configured interval until ctx is cancelled.
+func RunVacancyExpiryJob(ctx context.Context) {
+	interval := time.Duration(configs.AppSettings.VacancyParams.ExpiryCheckIntervalMinutes) * time.Minute
+	if interval <= 0 {
+		interval = defaultExpiryCheckInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if err := ExpireVacancies(time.Now()); err != nil {
+			logger.Error.Printf("[service.RunVacancyExpiryJob] Error expiring vacancies: %v\n", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func ExpireVacancies(now time.Time) (err error) {
+	vacancies, err := repository.ExpireVacancies(now, vacancyExpiredNotifications)
+	if err != nil {
+		return err
+	}
+	if len(vacancies) > 0 {
+		logger.Info.Printf("[service.ExpireVacancies] Expired %d vacancies\n", len(vacancies))
+	}
+	return nil
+}
+
+// vacancyExpiredNotifications tells the authors of the vacancies that they have expired.
+func vacancyExpiredNotifications(vacancies []models.Vacancy) []models.Notification {
+	notifications := make([]models.Notification, 0, len(vacancies))
+	for _, vacancy := range vacancies {
+		vacancyID := vacancy.ID
+		notifications = append(notifications, models.Notification{
+			UserID:    vacancy.UserID,
+			Type:      models.NotificationTypeVacancyExpired,
+			Title:     "Vacancy expired",
+			Message:   fmt.Sprintf("Your vacancy %q has expired and is no longer listed. Publish it again to extend it.", vacancy.Title),
+			VacancyID: &vacancyID,
+		})
+	}
+	return notifications
+}
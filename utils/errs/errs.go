@@ -58,4 +58,66 @@ var (
 	ErrUniquenessViolation                         = errors.New("ErrUniquenessViolation")
 	ErrResumeNotFound                              = errors.New("ErrResumeNotFound")
 	ErrVacancyNotFound                             = errors.New("ErrVacancyNotFound")
+	ErrRefreshTokenExpired                         = errors.New("ErrRefreshTokenExpired")
+	ErrRefreshTokenReused                          = errors.New("ErrRefreshTokenReused")
+	ErrTokenRevoked                                = errors.New("ErrTokenRevoked")
+	ErrNotCompanyMember                            = errors.New("ErrNotCompanyMember")
+	ErrAlreadyCompanyMember                        = errors.New("ErrAlreadyCompanyMember")
+	ErrInvalidCompanyMemberRole                    = errors.New("ErrInvalidCompanyMemberRole")
+	ErrCannotRemoveLastOwner                       = errors.New("ErrCannotRemoveLastOwner")
+	ErrInvitationNotPending                        = errors.New("ErrInvitationNotPending")
+	ErrCompanyBlocked                              = errors.New("ErrCompanyBlocked")
+	ErrCompanyNotVerified                          = errors.New("ErrCompanyNotVerified")
+	ErrInvalidVerificationStatus                   = errors.New("ErrInvalidVerificationStatus")
+	ErrInvalidSortField                            = errors.New("ErrInvalidSortField")
+	ErrInvalidCursor                               = errors.New("ErrInvalidCursor")
+	ErrInvalidApplicationStatus                    = errors.New("ErrInvalidApplicationStatus")
+	ErrInvalidStatusTransition                     = errors.New("ErrInvalidStatusTransition")
+	ErrAlreadyApplied                              = errors.New("ErrAlreadyApplied")
+	ErrCoverLetterTooLong                          = errors.New("ErrCoverLetterTooLong")
+	ErrNoteTextIsRequired                          = errors.New("ErrNoteTextIsRequired")
+	ErrNoteTooLong                                 = errors.New("ErrNoteTooLong")
+	ErrInvalidRating                               = errors.New("ErrInvalidRating")
+	ErrInvalidTimezone                             = errors.New("ErrInvalidTimezone")
+	ErrInvalidInterviewTime                        = errors.New("ErrInvalidInterviewTime")
+	ErrInterviewPlaceIsRequired                    = errors.New("ErrInterviewPlaceIsRequired")
+	ErrInvalidMeetingLink                          = errors.New("ErrInvalidMeetingLink")
+	ErrInvalidInterviewer                          = errors.New("ErrInvalidInterviewer")
+	ErrInvalidInterviewOutcome                     = errors.New("ErrInvalidInterviewOutcome")
+	ErrInterviewConflict                           = errors.New("ErrInterviewConflict")
+	ErrInterviewNotPending                         = errors.New("ErrInterviewNotPending")
+	ErrInterviewNotFound                           = errors.New("ErrInterviewNotFound")
+	ErrInvalidWorkExperience                       = errors.New("ErrInvalidWorkExperience")
+	ErrInvalidEducation                            = errors.New("ErrInvalidEducation")
+	ErrInvalidSkill                                = errors.New("ErrInvalidSkill")
+	ErrInvalidSkillProficiency                     = errors.New("ErrInvalidSkillProficiency")
+	ErrSkillNotFound                               = errors.New("ErrSkillNotFound")
+	ErrSkillAlreadyExist                           = errors.New("ErrSkillAlreadyExist")
+	ErrInvalidSalaryRange                          = errors.New("ErrInvalidSalaryRange")
+	ErrInvalidCurrency                             = errors.New("ErrInvalidCurrency")
+	ErrInvalidSalaryPeriod                         = errors.New("ErrInvalidSalaryPeriod")
+	ErrInvalidSalaryBasis                          = errors.New("ErrInvalidSalaryBasis")
+	ErrInvalidVacancyStatus                        = errors.New("ErrInvalidVacancyStatus")
+	ErrInvalidVacancyExpiry                        = errors.New("ErrInvalidVacancyExpiry")
+	ErrVacancyNotOpen                              = errors.New("ErrVacancyNotOpen")
+	ErrNotificationNotFound                        = errors.New("ErrNotificationNotFound")
+	ErrInvalidEmploymentType                       = errors.New("ErrInvalidEmploymentType")
+	ErrInvalidWorkSchedule                         = errors.New("ErrInvalidWorkSchedule")
+	ErrInvalidRemoteMode                           = errors.New("ErrInvalidRemoteMode")
+	ErrInvalidExperienceRange                      = errors.New("ErrInvalidExperienceRange")
+	ErrInvalidLanguage                             = errors.New("ErrInvalidLanguage")
+	ErrInvalidLanguageLevel                        = errors.New("ErrInvalidLanguageLevel")
+	ErrLocationNotFound                            = errors.New("ErrLocationNotFound")
+	ErrInvalidLocationType                         = errors.New("ErrInvalidLocationType")
+	ErrSavedSearchNameIsRequired                   = errors.New("ErrSavedSearchNameIsRequired")
+	ErrSavedSearchNameTooLong                      = errors.New("ErrSavedSearchNameTooLong")
+	ErrInvalidSavedSearchFrequency                 = errors.New("ErrInvalidSavedSearchFrequency")
+	ErrTooManySavedSearches                        = errors.New("ErrTooManySavedSearches")
+	ErrSavedSearchNotFound                         = errors.New("ErrSavedSearchNotFound")
+	ErrTooManyBookmarkTags                         = errors.New("ErrTooManyBookmarkTags")
+	ErrInvalidBookmarkTag                          = errors.New("ErrInvalidBookmarkTag")
+	ErrBookmarkNoteTooLong                         = errors.New("ErrBookmarkNoteTooLong")
+	ErrBookmarkNotFound                            = errors.New("ErrBookmarkNotFound")
+	ErrInvitationAlreadyPending                    = errors.New("ErrInvitationAlreadyPending")
+	ErrApplicationResumeLocked                     = errors.New("ErrApplicationResumeLocked")
 )
@@ -0,0 +1,122 @@
+package utils
+
+import (
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+)
+
+func TestFoldICSLine(t *testing.T) {
+	tests := []struct {
+		name string
+		line string
+		want string
+	}{
+		{"short", "SUMMARY:Interview", "SUMMARY:Interview"},
+		{"exactly the limit", strings.Repeat("a", 75), strings.Repeat("a", 75)},
+		{"one octet over", strings.Repeat("a", 76), strings.Repeat("a", 75) + "\r\n a"},
+		{"two folds", strings.Repeat("a", 75+74+1), strings.Repeat("a", 75) + "\r\n " + strings.Repeat("a", 74) + "\r\n a"},
+		{"multibyte at the limit", strings.Repeat("a", 74) + "я", strings.Repeat("a", 74) + "\r\n я"},
+		{"cyrillic", strings.Repeat("я", 40), strings.Repeat("я", 37) + "\r\n " + strings.Repeat("я", 3)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := foldICSLine(tt.line)
+			if got != tt.want {
+				t.Errorf("foldICSLine(%q) = %q, want %q", tt.line, got, tt.want)
+			}
+			for _, part := range strings.Split(got, "\r\n") {
+				if len(part) > 75 {
+					t.Errorf("folded line %q is %d octets long", part, len(part))
+				}
+				if !utf8.ValidString(part) {
+					t.Errorf("folded line %q breaks a UTF-8 sequence", part)
+				}
+			}
+			if unfolded := strings.ReplaceAll(got, "\r\n ", ""); unfolded != tt.line {
+				t.Errorf("unfolding gives %q, want %q", unfolded, tt.line)
+			}
+		})
+	}
+}
+
+func TestGenerateICS(t *testing.T) {
+	dushanbe := time.FixedZone("Asia/Dushanbe", 5*60*60)
+	event := CalendarEvent{
+		UID:         "interview-7@careerhub",
+		Sequence:    2,
+		Summary:     "Interview: Go developer, backend",
+		Description: "Interview for the vacancy.\nInterviewer: Ali",
+		Location:    "Dushanbe; office 3",
+		URL:         "https://meet.example.com/abc",
+		Start:       time.Date(2024, 5, 10, 14, 0, 0, 0, dushanbe),
+		End:         time.Date(2024, 5, 10, 15, 0, 0, 0, dushanbe),
+	}
+	cancelled := event
+	cancelled.Cancelled = true
+	minimal := CalendarEvent{UID: "interview-8@careerhub", Summary: "Interview", Start: event.Start, End: event.End}
+
+	tests := []struct {
+		name    string
+		event   CalendarEvent
+		want    []string
+		notWant []string
+	}{
+		{
+			name:  "confirmed",
+			event: event,
+			want: []string{
+				"BEGIN:VCALENDAR", "VERSION:2.0", "PRODID:-//careerhub//EN", "BEGIN:VEVENT",
+				"UID:interview-7@careerhub", "SEQUENCE:2",
+				"DTSTART:20240510T090000Z", "DTEND:20240510T100000Z",
+				"SUMMARY:Interview: Go developer\\, backend",
+				"DESCRIPTION:Interview for the vacancy.\\nInterviewer: Ali",
+				"LOCATION:Dushanbe\\; office 3",
+				"URL:https://meet.example.com/abc",
+				"STATUS:CONFIRMED", "END:VEVENT", "END:VCALENDAR",
+			},
+			notWant: []string{"STATUS:CANCELLED"},
+		},
+		{
+			name:    "cancelled",
+			event:   cancelled,
+			want:    []string{"STATUS:CANCELLED"},
+			notWant: []string{"STATUS:CONFIRMED"},
+		},
+		{
+			name:    "without optional fields",
+			event:   minimal,
+			want:    []string{"UID:interview-8@careerhub", "SEQUENCE:0", "SUMMARY:Interview", "STATUS:CONFIRMED"},
+			notWant: []string{"DESCRIPTION:", "LOCATION:", "URL:"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ics := string(GenerateICS("careerhub", tt.event))
+			if !strings.HasSuffix(ics, "\r\n") {
+				t.Errorf("document doesn't end with CRLF: %q", ics)
+			}
+			lines := strings.Split(strings.TrimSuffix(ics, "\r\n"), "\r\n")
+			has := make(map[string]bool, len(lines))
+			for _, line := range lines {
+				if strings.Contains(line, "\n") {
+					t.Errorf("line %q has a bare line feed", line)
+				}
+				has[line] = true
+			}
+			for _, line := range tt.want {
+				if !has[line] {
+					t.Errorf("missing line %q in\n%s", line, ics)
+				}
+			}
+			for _, prefix := range tt.notWant {
+				for _, line := range lines {
+					if strings.HasPrefix(line, prefix) {
+						t.Errorf("unexpected line %q", line)
+					}
+				}
+			}
+		})
+	}
+}
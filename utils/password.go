@@ -0,0 +1,111 @@
+package utils
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"golang.org/x/crypto/argon2"
+	"strings"
+)
+
+const (
+	argon2idPrefix  = "$argon2id$"
+	argon2idMemory  = 64 * 1024
+	argon2idTime    = 1
+	argon2idThreads = 4
+	argon2idKeyLen  = 32
+	argon2idSaltLen = 16
+	// argon2idMaxMemory bounds the memory, in KiB, a stored hash may ask for.
+	argon2idMaxMemory = 1024 * 1024
+)
+
+var ErrInvalidPasswordHash = errors.New("invalid password hash format")
+
+// HashPassword encodes the password with argon2id and a random per-user salt in the
+// PHC string format: $argon2id$v=19$m=65536,t=1,p=4$<salt>$<hash>.
+func HashPassword(password string) (string, error) {
+	salt := make([]byte, argon2idSaltLen)
+	if _, err := rand.Read(salt); err != nil {
+		return "", err
+	}
+	key := argon2.IDKey([]byte(password), salt, argon2idTime, argon2idMemory, argon2idThreads, argon2idKeyLen)
+	return fmt.Sprintf("%sv=%d$m=%d,t=%d,p=%d$%s$%s",
+		argon2idPrefix,
+		argon2.Version,
+		argon2idMemory,
+		argon2idTime,
+		argon2idThreads,
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(key),
+	), nil
+}
+
+// VerifyPassword checks the password against an encoded hash. needsRehash is true when
+// the stored hash uses a legacy algorithm (unsalted sha256) or outdated argon2id parameters.
+func VerifyPassword(password, encodedHash string) (ok bool, needsRehash bool, err error) {
+	if strings.HasPrefix(encodedHash, argon2idPrefix) {
+		return verifyArgon2id(password, encodedHash)
+	}
+	if isLegacySHA256(encodedHash) {
+		ok = subtle.ConstantTimeCompare([]byte(GenerateHash(password)), []byte(strings.ToLower(encodedHash))) == 1
+		return ok, ok, nil
+	}
+	return false, false, ErrInvalidPasswordHash
+}
+
+func verifyArgon2id(password, encodedHash string) (ok bool, needsRehash bool, err error) {
+	parts := strings.Split(encodedHash, "$")
+	if len(parts) != 6 {
+		return false, false, ErrInvalidPasswordHash
+	}
+
+	var version int
+	if _, err = fmt.Sscanf(parts[2], "v=%d", &version); err != nil {
+		return false, false, ErrInvalidPasswordHash
+	}
+	if version != argon2.Version {
+		return false, false, ErrInvalidPasswordHash
+	}
+
+	var memory, time uint32
+	var threads uint8
+	if _, err = fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &memory, &time, &threads); err != nil {
+		return false, false, ErrInvalidPasswordHash
+	}
+
+	salt, err := base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil {
+		return false, false, ErrInvalidPasswordHash
+	}
+	key, err := base64.RawStdEncoding.DecodeString(parts[5])
+	if err != nil {
+		return false, false, ErrInvalidPasswordHash
+	}
+	// argon2 panics on zero passes or threads, and an empty key would match any password.
+	if time == 0 || threads == 0 || memory > argon2idMaxMemory || len(salt) == 0 || len(key) == 0 {
+		return false, false, ErrInvalidPasswordHash
+	}
+
+	otherKey := argon2.IDKey([]byte(password), salt, time, memory, threads, uint32(len(key)))
+	if subtle.ConstantTimeCompare(key, otherKey) != 1 {
+		return false, false, nil
+	}
+
+	needsRehash = memory != argon2idMemory ||
+		time != argon2idTime ||
+		threads != argon2idThreads ||
+		len(key) != argon2idKeyLen ||
+		len(salt) != argon2idSaltLen
+	return true, needsRehash, nil
+}
+
+func isLegacySHA256(encodedHash string) bool {
+	if len(encodedHash) != 64 {
+		return false
+	}
+	_, err := hex.DecodeString(encodedHash)
+	return err == nil
+}
@@ -0,0 +1,114 @@
+package utils
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"golang.org/x/crypto/argon2"
+)
+
+// encodeArgon2id builds a hash in the same format as HashPassword, but with the given parameters.
+func encodeArgon2id(t *testing.T, password string, memory, time uint32, threads uint8, saltLen, keyLen uint32) string {
+	t.Helper()
+	salt := make([]byte, saltLen)
+	if _, err := rand.Read(salt); err != nil {
+		t.Fatal(err)
+	}
+	key := argon2.IDKey([]byte(password), salt, time, memory, threads, keyLen)
+	return fmt.Sprintf("$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s", argon2.Version, memory, time, threads,
+		base64.RawStdEncoding.EncodeToString(salt), base64.RawStdEncoding.EncodeToString(key))
+}
+
+func TestVerifyPassword(t *testing.T) {
+	hash, err := HashPassword("secret")
+	if err != nil {
+		t.Fatalf("HashPassword: %v", err)
+	}
+	if !strings.HasPrefix(hash, argon2idPrefix) {
+		t.Fatalf("HashPassword gave %q, want an argon2id hash", hash)
+	}
+	legacy := GenerateHash("secret")
+	salt := base64.RawStdEncoding.EncodeToString([]byte("0123456789abcdef"))
+	key := base64.RawStdEncoding.EncodeToString([]byte("0123456789abcdef0123456789abcdef"))
+
+	tests := []struct {
+		name            string
+		password        string
+		hash            string
+		wantOK          bool
+		wantNeedsRehash bool
+		wantErr         error
+	}{
+		{name: "argon2id round trip", password: "secret", hash: hash, wantOK: true},
+		{name: "wrong password", password: "Secret", hash: hash},
+		{name: "empty password", password: "", hash: hash},
+		{name: "legacy sha256", password: "secret", hash: legacy, wantOK: true, wantNeedsRehash: true},
+		{name: "legacy sha256 in upper case", password: "secret", hash: strings.ToUpper(legacy), wantOK: true, wantNeedsRehash: true},
+		{name: "legacy sha256 wrong password", password: "Secret", hash: legacy},
+		{
+			name: "other memory and passes", password: "secret",
+			hash:   encodeArgon2id(t, "secret", 32*1024, 2, argon2idThreads, argon2idSaltLen, argon2idKeyLen),
+			wantOK: true, wantNeedsRehash: true,
+		},
+		{
+			name: "other threads", password: "secret",
+			hash:   encodeArgon2id(t, "secret", argon2idMemory, argon2idTime, 2, argon2idSaltLen, argon2idKeyLen),
+			wantOK: true, wantNeedsRehash: true,
+		},
+		{
+			name: "other salt and key length", password: "secret",
+			hash:   encodeArgon2id(t, "secret", argon2idMemory, argon2idTime, argon2idThreads, 8, 16),
+			wantOK: true, wantNeedsRehash: true,
+		},
+		{
+			name: "other parameters wrong password", password: "Secret",
+			hash: encodeArgon2id(t, "secret", 32*1024, 2, 2, argon2idSaltLen, argon2idKeyLen),
+		},
+		{name: "empty", password: "secret", hash: "", wantErr: ErrInvalidPasswordHash},
+		{name: "plain text", password: "secret", hash: "secret", wantErr: ErrInvalidPasswordHash},
+		{name: "non-hex legacy", password: "secret", hash: strings.Repeat("z", 64), wantErr: ErrInvalidPasswordHash},
+		{name: "prefix only", password: "secret", hash: "$argon2id$", wantErr: ErrInvalidPasswordHash},
+		{name: "missing key", password: "secret", hash: "$argon2id$v=19$m=65536,t=1,p=4$" + salt, wantErr: ErrInvalidPasswordHash},
+		{name: "extra part", password: "secret", hash: "$argon2id$v=19$m=65536,t=1,p=4$" + salt + "$" + key + "$x", wantErr: ErrInvalidPasswordHash},
+		{name: "unknown version", password: "secret", hash: "$argon2id$v=18$m=65536,t=1,p=4$" + salt + "$" + key, wantErr: ErrInvalidPasswordHash},
+		{name: "garbled version", password: "secret", hash: "$argon2id$version$m=65536,t=1,p=4$" + salt + "$" + key, wantErr: ErrInvalidPasswordHash},
+		{name: "garbled parameters", password: "secret", hash: "$argon2id$v=19$m=x,t=1,p=4$" + salt + "$" + key, wantErr: ErrInvalidPasswordHash},
+		{name: "threads overflow", password: "secret", hash: "$argon2id$v=19$m=65536,t=1,p=300$" + salt + "$" + key, wantErr: ErrInvalidPasswordHash},
+		{name: "zero passes", password: "secret", hash: "$argon2id$v=19$m=65536,t=0,p=4$" + salt + "$" + key, wantErr: ErrInvalidPasswordHash},
+		{name: "zero threads", password: "secret", hash: "$argon2id$v=19$m=65536,t=1,p=0$" + salt + "$" + key, wantErr: ErrInvalidPasswordHash},
+		{name: "huge memory", password: "secret", hash: "$argon2id$v=19$m=4294967295,t=1,p=4$" + salt + "$" + key, wantErr: ErrInvalidPasswordHash},
+		{name: "bad salt encoding", password: "secret", hash: "$argon2id$v=19$m=65536,t=1,p=4$!!!$" + key, wantErr: ErrInvalidPasswordHash},
+		{name: "bad key encoding", password: "secret", hash: "$argon2id$v=19$m=65536,t=1,p=4$" + salt + "$!!!", wantErr: ErrInvalidPasswordHash},
+		{name: "empty salt", password: "secret", hash: "$argon2id$v=19$m=65536,t=1,p=4$$" + key, wantErr: ErrInvalidPasswordHash},
+		{name: "empty key", password: "secret", hash: "$argon2id$v=19$m=65536,t=1,p=4$" + salt + "$", wantErr: ErrInvalidPasswordHash},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ok, needsRehash, err := VerifyPassword(tt.password, tt.hash)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("VerifyPassword(%q) error = %v, want %v", tt.hash, err, tt.wantErr)
+			}
+			if ok != tt.wantOK || needsRehash != tt.wantNeedsRehash {
+				t.Errorf("VerifyPassword(%q) = %v, %v, want %v, %v", tt.hash, ok, needsRehash, tt.wantOK, tt.wantNeedsRehash)
+			}
+		})
+	}
+}
+
+func TestHashPasswordUsesFreshSalt(t *testing.T) {
+	first, err := HashPassword("secret")
+	if err != nil {
+		t.Fatalf("HashPassword: %v", err)
+	}
+	second, err := HashPassword("secret")
+	if err != nil {
+		t.Fatalf("HashPassword: %v", err)
+	}
+	if first == second {
+		t.Errorf("HashPassword gave the same hash twice: %q", first)
+	}
+}